@@ -0,0 +1,20 @@
+package billing
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+)
+
+const (
+	APIPathCosts BillingAPIPath = "billing/v%d/costs"
+)
+
+//BillingAPIPath is a path of the Billing API, versioned within the path such as `billing/v1/costs`
+type BillingAPIPath client.APIPath
+
+//Format builds the full URL of the path
+func (p BillingAPIPath) Format(client BillingClient, apiVersion int8) string {
+	return strings.Join([]string{client.Context.ResolveBaseURL(), fmt.Sprintf(string(p), apiVersion)}, "/")
+}
@@ -0,0 +1,100 @@
+package billing
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/telemetrytest"
+	"github.com/stretchr/testify/assert"
+)
+
+func cost(resource string, product string, amount float64) Cost {
+	return Cost{Resource: CostResource{ID: resource, DisplayName: resource}, Product: product, Amount: amount}
+}
+
+func TestListCosts(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/billing/v1/costs", r.URL.Path)
+		assert.Equal("2021-04-01", r.URL.Query().Get("start_date"))
+		assert.Equal("2021-05-01", r.URL.Query().Get("end_date"))
+
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			w.Write([]byte(`{"kind":"CostList","metadata":{"next":"https://api.confluent.cloud/billing/v1/costs?page_token=abc"},"data":[
+				{"start_date":"2021-04-01","end_date":"2021-04-02","granularity":"DAILY","line_type":"KAFKA_NUM_CKUS","product":"KAFKA","resource":{"id":"lkc-1","display_name":"orders","environment":{"id":"env-1"}},"price":1.5,"unit":"CKU-hour","quantity":24,"original_amount":36,"discount_amount":6,"amount":30}
+			]}`))
+		case "abc":
+			w.Write([]byte(`{"kind":"CostList","metadata":{},"data":[{"start_date":"2021-04-01","end_date":"2021-04-02","product":"CONNECT","resource":{"id":"lcc-1"},"amount":5}]}`))
+		}
+	}))
+	defer srv.Close()
+	c := New("key", "secret")
+	c.Context.BaseURL = srv.URL
+
+	costs, err := c.ListCosts(DateOf(time.Date(2021, 4, 1, 13, 0, 0, 0, time.UTC)), DateOf(time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)))
+	assert.NoError(err)
+	assert.Len(costs, 2, "All pages should be fetched")
+	assert.Equal("2021-04-02", costs[0].EndDate.String())
+	assert.Equal("env-1", costs[0].Resource.Environment.ID)
+	assert.Equal(35.0, Total(costs))
+
+	js, _ := json.Marshal(costs[0])
+	assert.Contains(string(js), `"start_date":"2021-04-01"`)
+}
+
+func TestByResource(t *testing.T) {
+	assert := assert.New(t)
+
+	grouped := ByResource([]Cost{
+		cost("lkc-1", ProductKafka, 10),
+		cost("lcc-1", ProductConnect, 25),
+		cost("lkc-1", ProductKafka, 20),
+		cost("lkc-2", ProductKafka, 5),
+	})
+	assert.Len(grouped, 3)
+	assert.Equal("lkc-1", grouped[0].ResourceID, "The highest cost should be first")
+	assert.Equal(30.0, grouped[0].Amount)
+	assert.Len(grouped[0].LineItems, 2)
+	assert.Equal("lkc-2", grouped[2].ResourceID)
+}
+
+func TestCostPerGB(t *testing.T) {
+	assert := assert.New(t)
+
+	start := DateOf(time.Now().Add(-72 * time.Hour))
+	end := DateOf(time.Now())
+	srv := telemetrytest.NewServer()
+	defer srv.Close()
+	srv.Add(
+		telemetrytest.NewPoint(metric.KafkaServerReceivedBytes.Name, start.Add(time.Hour), 3e9, "resource.kafka.id", "lkc-1", "metric.topic", "orders"),
+		telemetrytest.NewPoint(metric.KafkaServerReceivedBytes.Name, start.Add(25*time.Hour), 1e9, "resource.kafka.id", "lkc-1", "metric.topic", "orders"),
+		telemetrytest.NewPoint(metric.KafkaServerSentBytes.Name, start.Add(time.Hour), 6e9, "resource.kafka.id", "lkc-1", "metric.topic", "orders"),
+		telemetrytest.NewPoint(metric.KafkaServerRequests.Name, start.Add(time.Hour), 1000, "resource.kafka.id", "lkc-1", "metric.type", "Produce"),
+		telemetrytest.NewPoint(metric.KafkaServerReceivedBytes.Name, start.Add(time.Hour), 1e9, "resource.kafka.id", "lkc-3", "metric.topic", "orders"),
+	)
+	tc := telemetry.New("key", "secret")
+	tc.Context.BaseURL = srv.URL
+
+	reports, err := CostPerGB(&tc, []Cost{
+		cost("lkc-1", ProductKafka, 20),
+		cost("lkc-1", ProductKafka, 30),
+		cost("lkc-2", ProductKafka, 10),
+		cost("lcc-1", ProductConnect, 100),
+	}, start, end)
+	assert.NoError(err)
+	assert.Len(reports, 2, "Only Kafka Clusters with costs should be reported")
+	assert.Equal("lkc-1", reports[0].ResourceID)
+	assert.Equal(50.0, reports[0].Cost)
+	assert.Equal(4e9, reports[0].ReceivedBytes)
+	assert.Equal(6e9, reports[0].SentBytes)
+	assert.Equal(1000.0, reports[0].Requests)
+	assert.Equal(5.0, reports[0].CostPerGB())
+	assert.Equal(0.0, reports[1].CostPerGB(), "Clusters without traffic should have no cost per GB")
+}
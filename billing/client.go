@@ -0,0 +1,29 @@
+package billing
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/authenticater"
+)
+
+const (
+	//DefaultBaseURL is the default Domain and Protocol for the Confluent Cloud APIs
+	DefaultBaseURL string = client.DefaultCloudBaseURL
+	//DefaultPageSize is the default number of results to request per page when listing
+	DefaultPageSize int = 100
+)
+
+//BillingClient is the SDK Client for the Confluent Cloud Billing APIs
+type BillingClient struct {
+	client.Client
+	PageSize int
+}
+
+//New Used to create a new BillingClient from a Cloud API Key and Secret. The key must belong to an OrganizationAdmin
+func New(apiKey string, apiSecret string) BillingClient {
+	c := BillingClient{
+		PageSize: DefaultPageSize,
+		Client:   client.New(authenticater.NewAPIKeyAuth(apiKey, apiSecret), DefaultBaseURL, client.ErrorResponseHandler),
+	}
+	c.Context.Endpoint = client.Endpoint{Service: client.ServiceCloud}
+	return c
+}
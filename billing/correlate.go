@@ -0,0 +1,83 @@
+package billing
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+)
+
+//BytesPerGB is the number of bytes in a GB, as billed by Confluent Cloud
+const BytesPerGB float64 = 1e9
+
+//ClusterUsageCost is the cost of a Kafka Cluster alongside its usage, as reported by Telemetry, over the same period
+type ClusterUsageCost struct {
+	ResourceID    string
+	DisplayName   string
+	Cost          float64
+	ReceivedBytes float64
+	SentBytes     float64
+	Requests      float64
+}
+
+//GB returns the total bytes both received and sent, in GB
+func (c ClusterUsageCost) GB() float64 {
+	return (c.ReceivedBytes + c.SentBytes) / BytesPerGB
+}
+
+//CostPerGB returns the cost of each GB received or sent, or zero when the Cluster had no traffic
+func (c ClusterUsageCost) CostPerGB() float64 {
+	gb := c.GB()
+	if gb <= 0 {
+		return 0
+	}
+	return c.Cost / gb
+}
+
+//CostPerGB joins the Kafka Cluster Costs with the Cluster's received bytes, sent bytes and request totals from Telemetry, for the period between the start date, inclusive, and end date, exclusive.
+//The report has an entry per Cluster, sorted by the highest cost first
+func CostPerGB(telemetryClient *telemetry.TelemetryClient, costs []Cost, start Date, end Date) ([]ClusterUsageCost, error) {
+	index := map[string]int{}
+	reports := []ClusterUsageCost{}
+	for _, rc := range ByResource(costs) {
+		if rc.Product != ProductKafka || rc.ResourceID == "" {
+			continue
+		}
+		index[rc.ResourceID] = len(reports)
+		reports = append(reports, ClusterUsageCost{ResourceID: rc.ResourceID, DisplayName: rc.DisplayName, Cost: rc.Amount})
+	}
+	if len(reports) <= 0 {
+		return reports, nil
+	}
+
+	ids := make([]string, len(reports))
+	for i, r := range reports {
+		ids[i] = r.ResourceID
+	}
+
+	inter := interval.Between(start.Time, end.Time)
+	totals := []struct {
+		metric metric.Metric
+		add    func(r *ClusterUsageCost, value float64)
+	}{
+		{metric.KafkaServerReceivedBytes, func(r *ClusterUsageCost, value float64) { r.ReceivedBytes += value }},
+		{metric.KafkaServerSentBytes, func(r *ClusterUsageCost, value float64) { r.SentBytes += value }},
+		{metric.KafkaServerRequests, func(r *ClusterUsageCost, value float64) { r.Requests += value }},
+	}
+	for _, t := range totals {
+		data, err := telemetryClient.QueryKafkaMetricForClusters(ids, granularity.OneDay, inter, t.metric)
+		if err != nil {
+			return nil, err
+		}
+		for id, points := range data {
+			i, ok := index[id]
+			if !ok {
+				continue
+			}
+			for _, d := range points {
+				t.add(&reports[i], d.Value)
+			}
+		}
+	}
+	return reports, nil
+}
@@ -0,0 +1,149 @@
+package billing
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+)
+
+const (
+	//DateFormat is the format of the dates used by the Billing API
+	DateFormat string = "2006-01-02"
+
+	//ProductKafka is the product of Kafka Cluster costs
+	ProductKafka string = "KAFKA"
+	//ProductConnect is the product of Connector costs
+	ProductConnect string = "CONNECT"
+	//ProductKSQL is the product of ksqlDB Cluster costs
+	ProductKSQL string = "KSQL"
+	//ProductSupport is the product of support plan costs
+	ProductSupport string = "SUPPORT"
+)
+
+//Date is a calendar date, in UTC, as used by the Billing API
+type Date struct {
+	time.Time
+}
+
+//DateOf creates a Date from the year, month and day of a time
+func DateOf(t time.Time) Date {
+	return Date{time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
+}
+
+func (d Date) String() string {
+	return d.Format(DateFormat)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(js []byte) error {
+	var s string
+	if err := json.Unmarshal(js, &s); err != nil {
+		return err
+	}
+	t, err := time.Parse(DateFormat, s)
+	if err != nil {
+		return err
+	}
+	d.Time = t
+	return nil
+}
+
+//Cost is a single line item of the bill, for a resource and product over a period of time
+type Cost struct {
+	APIVersion        string       `json:"api_version,omitempty"`
+	Kind              string       `json:"kind,omitempty"`
+	StartDate         Date         `json:"start_date"`
+	EndDate           Date         `json:"end_date"`
+	Granularity       string       `json:"granularity"`
+	LineType          string       `json:"line_type"`
+	Product           string       `json:"product"`
+	Resource          CostResource `json:"resource"`
+	NetworkAccessType string       `json:"network_access_type,omitempty"`
+	Price             float64      `json:"price"`
+	Unit              string       `json:"unit"`
+	Quantity          float64      `json:"quantity"`
+	OriginalAmount    float64      `json:"original_amount"`
+	DiscountAmount    float64      `json:"discount_amount"`
+	Amount            float64      `json:"amount"`
+}
+
+//CostResource is the resource a Cost was billed for, such as a Kafka Cluster
+type CostResource struct {
+	ID          string                    `json:"id"`
+	DisplayName string                    `json:"display_name"`
+	Environment *response.ObjectReference `json:"environment,omitempty"`
+}
+
+//CostList is a single page of Costs
+type CostList = client.ListPage[Cost]
+
+//ResourceCost is the total of all the line items of a single resource and product
+type ResourceCost struct {
+	ResourceID  string
+	DisplayName string
+	Product     string
+	Amount      float64
+	LineItems   []Cost
+}
+
+//costs is the Resource of the Costs between the start date, inclusive, and end date, exclusive
+func costs(c *BillingClient, start Date, end Date) client.Resource[Cost] {
+	params := url.Values{}
+	params.Set("start_date", start.String())
+	params.Set("end_date", end.String())
+	return client.NewResource[Cost](&c.Client, APIPathCosts.Format(*c, 1), params, c.PageSize)
+}
+
+//Costs returns the Resource of the Costs between the start date, inclusive, and end date, exclusive, such as to iterate over them with List
+func (client *BillingClient) Costs(start Date, end Date) client.Resource[Cost] {
+	return costs(client, start, end)
+}
+
+//ListCostsPage returns a single page of Costs between the start date, inclusive, and end date, exclusive. An empty page token fetches the first page
+func (client *BillingClient) ListCostsPage(start Date, end Date, pageToken string) (CostList, error) {
+	return costs(client, start, end).ListPage(pageToken)
+}
+
+//ListCosts returns all the Costs, across all pages, between the start date, inclusive, and end date, exclusive.
+//The API limits the range to a year in the past and a month in length
+func (client *BillingClient) ListCosts(start Date, end Date) ([]Cost, error) {
+	return costs(client, start, end).ListAll()
+}
+
+//Total returns the sum of the amounts of all the Costs
+func Total(costs []Cost) float64 {
+	total := 0.0
+	for _, c := range costs {
+		total += c.Amount
+	}
+	return total
+}
+
+//ByResource groups the Costs by resource and product, sorted by the highest amount first
+func ByResource(costs []Cost) []ResourceCost {
+	index := map[string]int{}
+	grouped := []ResourceCost{}
+	for _, c := range costs {
+		key := c.Resource.ID + "\x00" + c.Product
+		i, ok := index[key]
+		if !ok {
+			i = len(grouped)
+			index[key] = i
+			grouped = append(grouped, ResourceCost{ResourceID: c.Resource.ID, DisplayName: c.Resource.DisplayName, Product: c.Product})
+		}
+		grouped[i].Amount += c.Amount
+		grouped[i].LineItems = append(grouped[i].LineItems, c)
+	}
+
+	sort.SliceStable(grouped, func(i, j int) bool {
+		return grouped[i].Amount > grouped[j].Amount
+	})
+	return grouped
+}
@@ -2,6 +2,8 @@ package client
 
 import (
 	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
 )
 
@@ -9,8 +11,28 @@ type APIPath string
 
 func (p APIPath) Format(client Client, apiVersion int8) string {
 	return strings.Join([]string{
-		client.Context.BaseURL,
+		client.Context.ResolveBaseURL(),
 		"v" + fmt.Sprint(apiVersion),
 		string(p),
 	}, "/")
 }
+
+//PageURL adds the `page_size` and `page_token` params of the Confluent Cloud v2 list APIs to a URL
+func PageURL(base string, pageSize int, pageToken string) string {
+	params := url.Values{}
+	if pageSize > 0 {
+		params.Set("page_size", strconv.Itoa(pageSize))
+	}
+	if pageToken != "" {
+		params.Set("page_token", pageToken)
+	}
+	if len(params) <= 0 {
+		return base
+	}
+
+	sep := "?"
+	if strings.Contains(base, "?") {
+		sep = "&"
+	}
+	return base + sep + params.Encode()
+}
@@ -19,7 +19,7 @@ func (a *APIKeyAuthenticater) UpdateSecret(key string) {
 	a.APISecret = client.SecurePassword(key)
 }
 
-func (a APIKeyAuthenticater) Authorize(req *http.Request) {
+func (a APIKeyAuthenticater) Authenticate(req *http.Request) {
 	req.SetBasicAuth(a.APIKey, a.APISecret.Value())
 }
 
@@ -1,7 +1,6 @@
 package authenticater
 
-import "net/http"
+import "github.com/nerdynick/ccloud-go-sdk/client"
 
-type Authenticater interface {
-	Authenticate(*http.Request)
-}
+//Authenticater is the interface a Client uses to add credentials to each request
+type Authenticater = client.Authenticater
@@ -2,12 +2,13 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"time"
 
-	"github.com/nerdynick/ccloud-go-sdk/client/authorizer"
 	"github.com/nerdynick/ccloud-go-sdk/logging"
 	"go.uber.org/zap"
 )
@@ -21,11 +22,16 @@ const (
 	DefaultMaxIdleConnsPerHost int = 5
 )
 
+//Authenticater adds the credentials of a Client to each of its requests
+type Authenticater interface {
+	Authenticate(*http.Request)
+}
+
 //Client HTTP Client Struct
 type Client struct {
 	*logging.Loggable
 	Context          Context
-	Authorizer       authorizer.Authenticater
+	Authorizer       Authenticater
 	httpClient       http.Client
 	HTTPErrorHandler func(int, []byte) error
 }
@@ -58,7 +64,7 @@ func (client *Client) Request(request *http.Request) ([]byte, error) {
 		return nil, err
 	}
 
-	if res.StatusCode != 200 {
+	if res.StatusCode < 200 || res.StatusCode > 299 {
 		err := client.HTTPErrorHandler(res.StatusCode, resBody)
 		error := NewError(res.StatusCode, request.RequestURI, err)
 
@@ -74,13 +80,51 @@ func (client *Client) Request(request *http.Request) ([]byte, error) {
 	if client.Log.Core().Enabled(logging.DebugLevel) {
 		client.Log.Debug("Request - Body",
 			zap.String("url", request.RequestURI),
-			zap.String("results", string(resBody)),
+			zap.String("results", Redact(resBody)),
 		)
 	}
 
 	return resBody, nil
 }
 
+//Stream Sends a Request to the given url, returning the response body unread for APIs that stream their results, such as over a chunked response.
+//The request isn't bound by the client's timeout and is instead cancelled with the context. The caller must close the returned body.
+func (client *Client) Stream(ctx context.Context, method string, url string, body []byte) (io.ReadCloser, error) {
+	request, err := client.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	request = request.WithContext(ctx)
+	client.Authorizer.Authenticate(request)
+
+	httpClient := client.httpClient
+	httpClient.Timeout = 0
+	res, err := httpClient.Do(request)
+	if err != nil {
+		client.Log.Error("Stream - Error returned from HTTP Request",
+			zap.String("url", url),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		defer res.Body.Close()
+		resBody, _ := ioutil.ReadAll(res.Body)
+		error := NewError(res.StatusCode, url, client.HTTPErrorHandler(res.StatusCode, resBody))
+
+		client.Log.Error("Stream - Invalid response code",
+			zap.String("url", url),
+			zap.Int("statusCode", res.StatusCode),
+			zap.String("statusMessage", res.Status),
+			zap.Error(error),
+		)
+		return nil, error
+	}
+
+	return res.Body, nil
+}
+
 //RequestAsync Sends a Request asynchronously
 func (client *Client) RequestAsync(request *http.Request, responseChan chan<- []byte, errorChan chan<- error) {
 	res, err := client.Request(request)
@@ -96,7 +140,7 @@ func (client *Client) NewRequest(method string, url string, body []byte) (*http.
 	client.Log.Debug("Creating Request",
 		zap.String("method", method),
 		zap.String("url", url),
-		zap.Binary("Body", body),
+		zap.String("Body", Redact(body)),
 	)
 
 	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
@@ -145,7 +189,7 @@ func (client *Client) Get(response interface{}, url string) error {
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(res, &response)
+	return unmarshal(res, response)
 }
 
 //SendGetAsync send a GET request to the API async
@@ -176,7 +220,47 @@ func (client Client) Post(response interface{}, url string, jsonBody interface{}
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(res, &response)
+	return unmarshal(res, response)
+}
+
+//Put send a PUT request with a given JSON Body
+func (client Client) Put(response interface{}, url string, jsonBody interface{}) error {
+	return client.sendJSON("PUT", response, url, jsonBody)
+}
+
+//Patch send a PATCH request with a given JSON Body
+func (client Client) Patch(response interface{}, url string, jsonBody interface{}) error {
+	return client.sendJSON("PATCH", response, url, jsonBody)
+}
+
+//Delete send a DELETE request to the API. The response may be nil when no body is expected
+func (client Client) Delete(response interface{}, url string) error {
+	res, err := client.SendRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+	return unmarshal(res, response)
+}
+
+func (client Client) sendJSON(method string, response interface{}, url string, jsonBody interface{}) error {
+	body, err := json.Marshal(jsonBody)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.SendRequest(method, url, body)
+	if err != nil {
+		return err
+	}
+	return unmarshal(res, response)
+}
+
+//unmarshal decodes a response body, skipping empty bodies such as those of a 204 No Content
+func unmarshal(body []byte, response interface{}) error {
+	if response == nil || len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+	return json.Unmarshal(body, &response)
 }
 
 //SendPostAsync send a POST request with a given JSON Body async
@@ -203,8 +287,18 @@ func (client *Client) PostAsync(responseSupplier ResponseSupplier, url string, j
 	}()
 }
 
+//SetTransport replaces the http.RoundTripper requests are sent with, such as with a Recorder for offline tests
+func (client *Client) SetTransport(transport http.RoundTripper) {
+	client.httpClient.Transport = transport
+}
+
+//Transport returns the http.RoundTripper requests are sent with
+func (client *Client) Transport() http.RoundTripper {
+	return client.httpClient.Transport
+}
+
 //New Creates a new CCloud Metrics HTTP Client
-func New(authorizer authorizer.Authorizer, baseURL string, httpErrorHandler func(int, []byte) error) Client {
+func New(authorizer Authenticater, baseURL string, httpErrorHandler func(int, []byte) error) Client {
 	log := logging.New("CCloudAPIClient")
 
 	return Client{
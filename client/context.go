@@ -1,5 +1,7 @@
 package client
 
+import "strings"
+
 const (
 	//DefaultUserAgent is the default user agent to send
 	DefaultUserAgent string = "ccloud-metrics-sdk/go"
@@ -9,7 +11,12 @@ const (
 type Context struct {
 	UserAgent   string
 	HTTPHeaders map[string]string
-	BaseURL     string
+	//BaseURL is the base URL used when no EndpointResolver has a mapping for the Endpoint
+	BaseURL string
+	//Endpoint describes the Service and resource this Client talks to
+	Endpoint Endpoint
+	//Resolver overrides the base URL of the Endpoint, such as for Private Networking, proxies or test servers. Defaults to DefaultResolver when nil
+	Resolver EndpointResolver
 }
 
 //NewContext creates a new instance of the HTTPContext loaded with the defaults where possible
@@ -20,3 +27,16 @@ func NewContext(baseURL string) Context {
 		BaseURL:     baseURL,
 	}
 }
+
+//ResolveBaseURL returns the base URL to send requests to, consulting the Resolver, then the DefaultResolver, before falling back to the BaseURL
+func (c Context) ResolveBaseURL() string {
+	for _, r := range []EndpointResolver{c.Resolver, DefaultResolver} {
+		if r == nil {
+			continue
+		}
+		if u, ok := r.ResolveEndpoint(c.Endpoint); ok && u != "" {
+			return strings.TrimSuffix(u, "/")
+		}
+	}
+	return c.BaseURL
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"strings"
+	"sync"
+)
+
+const (
+	//DefaultCloudBaseURL is the default Domain and Protocol for the Confluent Cloud APIs
+	DefaultCloudBaseURL string = "https://api.confluent.cloud"
+	//DefaultTelemetryBaseURL is the default Domain and Protocol for the Confluent Cloud Metrics API
+	DefaultTelemetryBaseURL string = "https://api.telemetry.confluent.cloud"
+
+	//ServiceCloud is the Confluent Cloud management APIs served from api.confluent.cloud
+	ServiceCloud Service = "cloud"
+	//ServiceTelemetry is the Metrics API served from api.telemetry.confluent.cloud
+	ServiceTelemetry Service = "telemetry"
+	//ServiceKafkaREST is the Kafka REST API served from each Kafka Cluster's own endpoint
+	ServiceKafkaREST Service = "kafka-rest"
+	//ServiceSchemaRegistry is the Schema Registry API served from each region's Schema Registry endpoint
+	ServiceSchemaRegistry Service = "schema-registry"
+	//ServiceKSQL is the ksqlDB API served from each ksqlDB Cluster's own endpoint
+	ServiceKSQL Service = "ksql"
+)
+
+var (
+	//DefaultServiceURLs are the base URLs of the Services that are served from a single global host
+	DefaultServiceURLs map[Service]string = map[Service]string{
+		ServiceCloud:     DefaultCloudBaseURL,
+		ServiceTelemetry: DefaultTelemetryBaseURL,
+	}
+
+	//DefaultResolver is consulted by every Client that doesn't have its own `Context.Resolver`. It is nil by default, leaving each Client on its `Context.BaseURL`
+	DefaultResolver EndpointResolver
+)
+
+//Service is a Confluent Cloud product that is served from its own set of hosts
+type Service string
+
+//Endpoint describes what a Client is talking to, so that an EndpointResolver can map it to a base URL
+type Endpoint struct {
+	Service    Service
+	ResourceID string
+	Cloud      string
+	Region     string
+}
+
+//EndpointResolver maps an Endpoint to the base URL to send its requests to. The bool is false when the resolver has no mapping for the Endpoint
+type EndpointResolver interface {
+	ResolveEndpoint(endpoint Endpoint) (string, bool)
+}
+
+//EndpointResolverFunc adapts a plain function to an EndpointResolver
+type EndpointResolverFunc func(endpoint Endpoint) (string, bool)
+
+//ResolveEndpoint calls f(endpoint)
+func (f EndpointResolverFunc) ResolveEndpoint(endpoint Endpoint) (string, bool) {
+	return f(endpoint)
+}
+
+//StaticResolver resolves every Endpoint to the same base URL, such as a proxy or a test server
+func StaticResolver(baseURL string) EndpointResolver {
+	return EndpointResolverFunc(func(Endpoint) (string, bool) {
+		return baseURL, true
+	})
+}
+
+//Endpoints is an EndpointResolver that maps Services to base URLs, with overrides per resource and per region.
+//
+//Base URLs may contain the `{resource}`, `{cloud}` and `{region}` placeholders which are filled in from the Endpoint being resolved.
+//An Endpoint is first matched on its ResourceID, then its Region, and finally its Service.
+type Endpoints struct {
+	mu        sync.RWMutex
+	services  map[Service]string
+	resources map[Endpoint]string
+	regions   map[Endpoint]string
+}
+
+//NewEndpoints creates a new set of Endpoints loaded with the DefaultServiceURLs
+func NewEndpoints() *Endpoints {
+	e := &Endpoints{
+		services:  map[Service]string{},
+		resources: map[Endpoint]string{},
+		regions:   map[Endpoint]string{},
+	}
+	for s, u := range DefaultServiceURLs {
+		e.services[s] = u
+	}
+	return e
+}
+
+//SetService sets the base URL of every Endpoint of the given Service
+func (e *Endpoints) SetService(service Service, baseURL string) *Endpoints {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.services[service] = baseURL
+	return e
+}
+
+//SetResource sets the base URL of a single resource, such as a Kafka Cluster's REST endpoint or its Private Link address
+func (e *Endpoints) SetResource(service Service, resourceID string, baseURL string) *Endpoints {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.resources[Endpoint{Service: service, ResourceID: resourceID}] = baseURL
+	return e
+}
+
+//SetRegion sets the base URL of a Service within a single cloud region, such as a region's Schema Registry endpoint
+func (e *Endpoints) SetRegion(service Service, cloud string, region string, baseURL string) *Endpoints {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.regions[Endpoint{Service: service, Cloud: strings.ToLower(cloud), Region: region}] = baseURL
+	return e
+}
+
+//ResolveEndpoint resolves the Endpoint to the most specific base URL that is set for it
+func (e *Endpoints) ResolveEndpoint(endpoint Endpoint) (string, bool) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if endpoint.ResourceID != "" {
+		if u, ok := e.resources[Endpoint{Service: endpoint.Service, ResourceID: endpoint.ResourceID}]; ok {
+			return expandEndpoint(u, endpoint)
+		}
+	}
+	if endpoint.Region != "" {
+		if u, ok := e.regions[Endpoint{Service: endpoint.Service, Cloud: strings.ToLower(endpoint.Cloud), Region: endpoint.Region}]; ok {
+			return expandEndpoint(u, endpoint)
+		}
+	}
+	if u, ok := e.services[endpoint.Service]; ok {
+		return expandEndpoint(u, endpoint)
+	}
+	return "", false
+}
+
+//expandEndpoint fills in the placeholders of a base URL, failing when the Endpoint is missing a value for one of them
+func expandEndpoint(baseURL string, endpoint Endpoint) (string, bool) {
+	for placeholder, value := range map[string]string{
+		"{resource}": endpoint.ResourceID,
+		"{cloud}":    strings.ToLower(endpoint.Cloud),
+		"{region}":   endpoint.Region,
+	} {
+		if !strings.Contains(baseURL, placeholder) {
+			continue
+		}
+		if value == "" {
+			return "", false
+		}
+		baseURL = strings.ReplaceAll(baseURL, placeholder, value)
+	}
+	return strings.TrimSuffix(baseURL, "/"), true
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpoints(t *testing.T) {
+	assert := assert.New(t)
+
+	e := NewEndpoints().
+		SetService(ServiceSchemaRegistry, "https://sr.{region}.{cloud}.example.com/").
+		SetRegion(ServiceSchemaRegistry, "AWS", "us-east-2", "https://psrc-abc123.us-east-2.aws.confluent.cloud").
+		SetResource(ServiceKafkaREST, "lkc-abc123", "https://lkc-abc123.private.example.com")
+
+	u, ok := e.ResolveEndpoint(Endpoint{Service: ServiceCloud})
+	assert.True(ok)
+	assert.Equal(DefaultCloudBaseURL, u, "Global services should default to their public host")
+
+	u, ok = e.ResolveEndpoint(Endpoint{Service: ServiceTelemetry, ResourceID: "lkc-abc123"})
+	assert.True(ok)
+	assert.Equal(DefaultTelemetryBaseURL, u, "Resource overrides should only apply to their own service")
+
+	u, ok = e.ResolveEndpoint(Endpoint{Service: ServiceKafkaREST, ResourceID: "lkc-abc123"})
+	assert.True(ok)
+	assert.Equal("https://lkc-abc123.private.example.com", u)
+
+	_, ok = e.ResolveEndpoint(Endpoint{Service: ServiceKafkaREST, ResourceID: "lkc-xyz789"})
+	assert.False(ok, "Per cluster services have no default host")
+
+	u, ok = e.ResolveEndpoint(Endpoint{Service: ServiceSchemaRegistry, Cloud: "aws", Region: "us-east-2"})
+	assert.True(ok)
+	assert.Equal("https://psrc-abc123.us-east-2.aws.confluent.cloud", u, "Region overrides should win over the service")
+
+	u, ok = e.ResolveEndpoint(Endpoint{Service: ServiceSchemaRegistry, Cloud: "GCP", Region: "us-central1"})
+	assert.True(ok)
+	assert.Equal("https://sr.us-central1.gcp.example.com", u, "Placeholders should be filled in from the endpoint")
+
+	_, ok = e.ResolveEndpoint(Endpoint{Service: ServiceSchemaRegistry})
+	assert.False(ok, "Placeholders without a value should not resolve")
+}
+
+func TestResolveBaseURL(t *testing.T) {
+	assert := assert.New(t)
+
+	c := New(noAuth{}, DefaultCloudBaseURL, ErrorResponseHandler)
+	c.Context.Endpoint = Endpoint{Service: ServiceCloud}
+	assert.Equal("https://api.confluent.cloud/v2/foo", APIPath("foo").Format(c, 2), "Without a resolver the BaseURL should be used")
+
+	c.Context.Resolver = StaticResolver("http://localhost:8080/")
+	assert.Equal("http://localhost:8080/v2/foo", APIPath("foo").Format(c, 2))
+
+	c.Context.Resolver = NewEndpoints().SetResource(ServiceKafkaREST, "lkc-abc123", "https://proxy.example.com")
+	assert.Equal("https://api.confluent.cloud/v2/foo", APIPath("foo").Format(c, 2), "Unresolved endpoints should fall back to the BaseURL")
+
+	c.Context.Resolver = nil
+	previous := DefaultResolver
+	t.Cleanup(func() { DefaultResolver = previous })
+	DefaultResolver = NewEndpoints().SetService(ServiceCloud, "https://proxy.example.com")
+	assert.Equal("https://proxy.example.com/v2/foo", APIPath("foo").Format(c, 2), "The DefaultResolver should be used without a Client resolver")
+}
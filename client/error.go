@@ -1,6 +1,11 @@
 package client
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+)
 
 //Error represents a Generic API Client error
 type Error struct {
@@ -10,7 +15,12 @@ type Error struct {
 }
 
 func (err Error) Error() string {
-	return fmt.Sprintf("Received status code (%d) instead of 200 for a call to %s: %s", err.HTTPStatusCode, err.URL, err.error.Error())
+	return fmt.Sprintf("Received status code (%d) instead of 2xx for a call to %s: %s", err.HTTPStatusCode, err.URL, err.error.Error())
+}
+
+//Unwrap returns the underlying error, such as the decoded API error response
+func (err Error) Unwrap() error {
+	return err.error
 }
 
 //RateLimitedError struct to represent a Rate Limit has been hit for the given account
@@ -37,3 +47,10 @@ func NewError(httpStatusCode int, url string, error error) error {
 		URL:            url,
 	}
 }
+
+//ErrorResponseHandler is a HTTPErrorHandler that decodes the standard Confluent Cloud JSON error body
+func ErrorResponseHandler(statusCode int, body []byte) error {
+	err := response.ErrorResponse{}
+	json.Unmarshal(body, &err)
+	return err
+}
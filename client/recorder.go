@@ -0,0 +1,310 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+//RecorderMode controls if a Recorder sends requests on to the API and records them, or serves them from a Cassette
+type RecorderMode string
+
+const (
+	//ModeRecord sends all requests on to the API, saving the scrubbed request/response pairs to the Cassette on Stop
+	ModeRecord RecorderMode = "record"
+	//ModeReplay serves all requests from the Cassette, failing any request that wasn't recorded
+	ModeReplay RecorderMode = "replay"
+	//ModeAuto replays from the Cassette when the file exists, otherwise records it
+	ModeAuto RecorderMode = "auto"
+
+	//Scrubbed is the value credentials are replaced with before being saved to a Cassette
+	Scrubbed string = "****SCRUBBED****"
+)
+
+var (
+	//DefaultScrubbedHeaders is the collection of headers, containing credentials, that are scrubbed before being saved to a Cassette
+	DefaultScrubbedHeaders []string = []string{
+		"Authorization",
+		"Cookie",
+		"Set-Cookie",
+		"Proxy-Authorization",
+	}
+)
+
+//ScrubBodies masks the values of any secret fields, such as an API Key's secret, within the request and response bodies of an Interaction.
+//Recorders apply it by default.
+func ScrubBodies(interaction *Interaction) {
+	interaction.Request.Body = Redact([]byte(interaction.Request.Body))
+	interaction.Response.Body = Redact([]byte(interaction.Response.Body))
+}
+
+//RecordedRequest is the saved form of a HTTP Request
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+//RecordedResponse is the saved form of a HTTP Response
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+//Interaction is a single recorded request/response pair
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+//Cassette is a collection of Interactions saved to, and loaded from, a JSON file
+type Cassette struct {
+	Path         string        `json:"-"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+//LoadCassette reads a Cassette from the given file
+func LoadCassette(path string) (*Cassette, error) {
+	js, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cassette := &Cassette{}
+	if err := json.Unmarshal(js, cassette); err != nil {
+		return nil, fmt.Errorf("Invalid cassette `%s`: %s", path, err)
+	}
+	cassette.Path = path
+	return cassette, nil
+}
+
+//Save writes the Cassette out to its file, creating any missing directories
+func (c *Cassette) Save() error {
+	js, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.Path, js, 0644)
+}
+
+//Recorder is a http.RoundTripper that records request/response pairs to a Cassette, or replays them back from one.
+//Requests are matched on their method, path, query and normalized JSON body, ignoring the values of secret fields as those are scrubbed before saving.
+//
+//	rec, err := client.NewRecorder("testdata/query.json", client.ModeAuto, nil)
+//	telemetryClient.SetTransport(rec)
+//	defer rec.Stop()
+type Recorder struct {
+	Mode     RecorderMode
+	Cassette *Cassette
+	//Transport is the underlying transport requests are sent with while recording. Defaults to http.DefaultTransport
+	Transport http.RoundTripper
+	//ScrubbedHeaders are the headers, containing credentials, that are scrubbed before saving
+	ScrubbedHeaders []string
+	//Scrubbers are applied to each Interaction before it's saved, for scrubbing credentials from URLs or bodies. Defaults to ScrubBodies
+	Scrubbers []func(*Interaction)
+
+	mutex  sync.Mutex
+	played map[int]bool
+}
+
+//NewRecorder creates a Recorder for the Cassette at the given path.
+//In ModeReplay the Cassette must exist, where as in ModeAuto a missing Cassette switches the Recorder to ModeRecord.
+func NewRecorder(path string, mode RecorderMode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	rec := &Recorder{
+		Mode:            mode,
+		Cassette:        &Cassette{Path: path},
+		Transport:       transport,
+		ScrubbedHeaders: DefaultScrubbedHeaders,
+		Scrubbers:       []func(*Interaction){ScrubBodies},
+		played:          map[int]bool{},
+	}
+
+	switch mode {
+	case ModeRecord:
+		return rec, nil
+	case ModeReplay, ModeAuto:
+		cassette, err := LoadCassette(path)
+		if err == nil {
+			rec.Mode = ModeReplay
+			rec.Cassette = cassette
+			return rec, nil
+		}
+		if mode == ModeAuto && os.IsNotExist(err) {
+			rec.Mode = ModeRecord
+			return rec, nil
+		}
+		return nil, err
+	default:
+		return nil, fmt.Errorf("Unknown recorder mode `%s`", mode)
+	}
+}
+
+//RoundTrip sends, or replays, a single request
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Mode == ModeReplay {
+		return r.replay(req, body)
+	}
+	return r.record(req, body)
+}
+
+//Stop saves the Cassette when recording. Nothing is done while replaying
+func (r *Recorder) Stop() error {
+	if r.Mode != ModeRecord {
+		return nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.Cassette.Save()
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	res, err := r.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(resBody))
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: r.scrubHeaders(req.Header),
+			Body:    string(body),
+		},
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Headers:    r.scrubHeaders(res.Header),
+			Body:       string(resBody),
+		},
+	}
+	if req.URL.User != nil {
+		u := *req.URL
+		u.User = nil
+		interaction.Request.URL = u.String()
+	}
+	for _, scrub := range r.Scrubbers {
+		scrub(&interaction)
+	}
+
+	r.mutex.Lock()
+	r.Cassette.Interactions = append(r.Cassette.Interactions, interaction)
+	r.mutex.Unlock()
+
+	return res, nil
+}
+
+//replay serves the first unplayed matching Interaction. Once all matches have been played the last one is served again.
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	key := matchKey(req.Method, req.URL.Path, req.URL.Query().Encode(), body)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	match := -1
+	for i, interaction := range r.Cassette.Interactions {
+		if interaction.Request.key() != key {
+			continue
+		}
+		match = i
+		if !r.played[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("No recorded interaction in cassette `%s` matches %s %s %s", r.Cassette.Path, req.Method, req.URL.RequestURI(), string(body))
+	}
+	r.played[match] = true
+
+	recorded := r.Cassette.Interactions[match].Response
+	headers := http.Header{}
+	for k, v := range recorded.Headers {
+		headers[k] = v
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          ioutil.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) scrubHeaders(headers http.Header) http.Header {
+	scrubbed := http.Header{}
+	for k, v := range headers {
+		scrubbed[k] = v
+	}
+	for _, h := range r.ScrubbedHeaders {
+		if scrubbed.Get(h) != "" {
+			scrubbed.Set(h, Scrubbed)
+		}
+	}
+	return scrubbed
+}
+
+func (req RecordedRequest) key() string {
+	u, err := url.Parse(req.URL)
+	if err != nil {
+		return matchKey(req.Method, req.URL, "", []byte(req.Body))
+	}
+	return matchKey(req.Method, u.Path, u.Query().Encode(), []byte(req.Body))
+}
+
+//matchKey builds the key requests are matched on. JSON bodies are normalized, so field order and whitespace don't matter,
+//and redacted, so recorded requests whose secrets were scrubbed still match
+func matchKey(method string, path string, query string, body []byte) string {
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err == nil {
+		if normalized, err := json.Marshal(decoded); err == nil {
+			body = normalized
+		}
+	}
+	return strings.Join([]string{strings.ToUpper(method), path, query, Redact(bytes.TrimSpace(body))}, " ")
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
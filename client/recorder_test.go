@@ -0,0 +1,135 @@
+package client
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "query.json")
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Write([]byte(`{"calls":` + strconv.Itoa(calls) + `,"echo":` + string(body) + `}`))
+	}))
+	defer server.Close()
+
+	rec, err := NewRecorder(path, ModeAuto, nil)
+	assert.NoError(err)
+	assert.Equal(ModeRecord, rec.Mode, "A missing cassette should be recorded")
+
+	client := http.Client{Transport: rec}
+	res := send(t, client, "POST", server.URL+"/v2/metrics/cloud/query", `{"metric":"a","limit":1}`)
+	assert.Equal(`{"calls":1,"echo":{"metric":"a","limit":1}}`, res)
+	res = send(t, client, "POST", server.URL+"/v2/metrics/cloud/query", `{"metric":"a","limit":1}`)
+	assert.Equal(`{"calls":2,"echo":{"metric":"a","limit":1}}`, res)
+	assert.NoError(rec.Stop())
+
+	js, err := ioutil.ReadFile(path)
+	assert.NoError(err)
+	assert.NotContains(string(js), "my-api-secret", "Credentials should be scrubbed")
+	assert.NotContains(string(js), "session=secret", "Credentials should be scrubbed")
+	assert.Contains(string(js), Scrubbed)
+
+	rec, err = NewRecorder(path, ModeAuto, nil)
+	assert.NoError(err)
+	assert.Equal(ModeReplay, rec.Mode, "An existing cassette should be replayed")
+
+	client = http.Client{Transport: rec}
+	res = send(t, client, "POST", server.URL+"/v2/metrics/cloud/query", "{\n  \"limit\": 1,\n  \"metric\": \"a\"\n}")
+	assert.Equal(`{"calls":1,"echo":{"metric":"a","limit":1}}`, res, "Bodies should be matched once normalized")
+	res = send(t, client, "POST", server.URL+"/v2/metrics/cloud/query", `{"metric":"a","limit":1}`)
+	assert.Equal(`{"calls":2,"echo":{"metric":"a","limit":1}}`, res, "Matches should be replayed in order")
+	res = send(t, client, "POST", server.URL+"/v2/metrics/cloud/query", `{"metric":"a","limit":1}`)
+	assert.Equal(`{"calls":2,"echo":{"metric":"a","limit":1}}`, res, "The last match should be repeated once all are played")
+	assert.Equal(2, calls, "Replays shouldn't hit the server")
+
+	req, _ := http.NewRequest("POST", server.URL+"/v2/metrics/cloud/query", strings.NewReader(`{"metric":"b"}`))
+	_, err = client.Do(req)
+	assert.Error(err, "Unmatched requests should fail")
+	assert.Contains(err.Error(), "No recorded interaction")
+}
+
+func TestRecorderScrubsBodies(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "api-key.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"KEY","spec":{"secret":"my-key-secret","display_name":"ci"}}`))
+	}))
+	defer server.Close()
+
+	rec, err := NewRecorder(path, ModeRecord, nil)
+	assert.NoError(err)
+
+	client := http.Client{Transport: rec}
+	res := send(t, client, "POST", server.URL+"/iam/v2/api-keys", `{"spec":{"display_name":"ci","password":"my-password"}}`)
+	assert.Contains(res, "my-key-secret", "The caller should still get the secret")
+	assert.NoError(rec.Stop())
+
+	js, err := ioutil.ReadFile(path)
+	assert.NoError(err)
+	assert.NotContains(string(js), "my-key-secret", "Secrets in response bodies should be scrubbed")
+	assert.NotContains(string(js), "my-password", "Secrets in request bodies should be scrubbed")
+
+	rec, err = NewRecorder(path, ModeReplay, nil)
+	assert.NoError(err)
+
+	client = http.Client{Transport: rec}
+	res = send(t, client, "POST", server.URL+"/iam/v2/api-keys", `{"spec":{"display_name":"ci","password":"my-password"}}`)
+	assert.Equal(`{"id":"KEY","spec":{"secret":"`+SecureMask+`","display_name":"ci"}}`, res, "Requests with scrubbed secrets should still be matched")
+}
+
+func TestRecorderReplayMissingCassette(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewRecorder(filepath.Join(os.TempDir(), "does-not-exist", "cassette.json"), ModeReplay, nil)
+	assert.Error(err, "Replaying a missing cassette should fail")
+
+	_, err = NewRecorder("cassette.json", RecorderMode("unknown"), nil)
+	assert.Error(err)
+}
+
+func send(t *testing.T, client http.Client, method string, url string, body string) string {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Basic my-api-secret")
+
+	res, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	resBody, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(resBody)
+}
@@ -0,0 +1,154 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+)
+
+//ListPage is a single page of a Confluent Cloud v2 list API
+type ListPage[T any] struct {
+	APIVersion string                `json:"api_version,omitempty"`
+	Kind       string                `json:"kind,omitempty"`
+	Metadata   response.ListMetadata `json:"metadata"`
+	Data       []T                   `json:"data"`
+}
+
+//Resource is a collection of a Confluent Cloud v2 API, such as `org/v2/environments`, that all follow the same shape of
+//list pages with `next` page tokens, single resources by ID, and JSON error bodies. T is the typed resource, such as an Environment
+type Resource[T any] struct {
+	Client *Client
+	//URL is the full URL of the collection, such as `https://api.confluent.cloud/org/v2/environments`
+	URL string
+	//Params are sent with every request, such as the `environment` that scopes Kafka Clusters
+	Params   url.Values
+	PageSize int
+}
+
+//NewResource creates a new Resource for the collection at the given URL
+func NewResource[T any](client *Client, url string, params url.Values, pageSize int) Resource[T] {
+	return Resource[T]{
+		Client:   client,
+		URL:      url,
+		Params:   params,
+		PageSize: pageSize,
+	}
+}
+
+//url builds the URL of the collection, or of a single resource when given its ID, with the resource's params
+func (r Resource[T]) url(id string) string {
+	u := r.URL
+	if id != "" {
+		u += "/" + url.PathEscape(id)
+	}
+	if len(r.Params) > 0 {
+		u += "?" + r.Params.Encode()
+	}
+	return u
+}
+
+//ListPage returns a single page of the collection. An empty page token fetches the first page
+func (r Resource[T]) ListPage(pageToken string) (ListPage[T], error) {
+	page := ListPage[T]{}
+	err := r.Client.Get(&page, PageURL(r.url(""), r.PageSize, pageToken))
+	return page, err
+}
+
+//List returns an Iterator over the whole collection, fetching pages as they're needed
+func (r Resource[T]) List() *Iterator[T] {
+	return &Iterator[T]{resource: r}
+}
+
+//ListAll returns the whole collection, across all pages. On error, the resources listed so far are returned alongside it
+func (r Resource[T]) ListAll() ([]T, error) {
+	all := []T{}
+	it := r.List()
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
+
+//Get returns a single resource by its ID
+func (r Resource[T]) Get(id string) (T, error) {
+	var res T
+	err := r.Client.Get(&res, r.url(id))
+	return res, err
+}
+
+//Create creates a new resource from the request body, such as its spec
+func (r Resource[T]) Create(body interface{}) (T, error) {
+	var res T
+	err := r.Client.Post(&res, r.url(""), body)
+	return res, err
+}
+
+//Update patches an existing resource with the request body. Only the fields set within the body are changed
+func (r Resource[T]) Update(id string, body interface{}) (T, error) {
+	var res T
+	err := r.Client.Patch(&res, r.url(id), body)
+	return res, err
+}
+
+//Delete deletes a resource by its ID
+func (r Resource[T]) Delete(id string) error {
+	return r.Client.Delete(nil, r.url(id))
+}
+
+//Iterator iterates over all the resources of a collection, fetching each page as the previous is exhausted
+//
+//	it := resource.List()
+//	for it.Next() {
+//		env := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type Iterator[T any] struct {
+	resource Resource[T]
+	page     []T
+	index    int
+	token    string
+	started  bool
+	err      error
+}
+
+//Next advances to the next resource, fetching the next page when needed. It returns false once the collection is exhausted, a page fails to be fetched, or a page returns its own token as the next
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	it.index++
+	for it.index >= len(it.page) {
+		if it.started && it.token == "" {
+			return false
+		}
+
+		page, err := it.resource.ListPage(it.token)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		next := page.Metadata.NextPageToken()
+		if next != "" && next == it.token {
+			it.err = fmt.Errorf("Page token `%s` was returned twice", next)
+			return false
+		}
+		it.started = true
+		it.token = next
+		it.page = page.Data
+		it.index = 0
+	}
+	return true
+}
+
+//Value returns the current resource
+func (it *Iterator[T]) Value() T {
+	return it.page[it.index]
+}
+
+//Err returns the error, if any, that stopped the iteration
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type noAuth struct{}
+
+func (noAuth) Authenticate(req *http.Request) {}
+
+type testResource struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"display_name"`
+}
+
+func newTestResource(handler http.HandlerFunc) (*httptest.Server, Resource[testResource]) {
+	srv := httptest.NewServer(handler)
+	c := New(noAuth{}, srv.URL, ErrorResponseHandler)
+	return srv, NewResource[testResource](&c, srv.URL+"/test/v2/things", url.Values{"environment": {"env-1"}}, 2)
+}
+
+func TestResourceList(t *testing.T) {
+	assert := assert.New(t)
+
+	pages := 0
+	srv, r := newTestResource(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		assert.Equal("env-1", r.URL.Query().Get("environment"), "Params should be sent with every page")
+		assert.Equal("2", r.URL.Query().Get("page_size"))
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			w.Write([]byte(`{"kind":"ThingList","metadata":{"next":"https://example.com/test/v2/things?page_token=a"},"data":[{"id":"t-1"},{"id":"t-2"}]}`))
+		case "a":
+			w.Write([]byte(`{"kind":"ThingList","metadata":{"next":"https://example.com/test/v2/things?page_token=b"},"data":[]}`))
+		case "b":
+			w.Write([]byte(`{"kind":"ThingList","metadata":{},"data":[{"id":"t-3"}]}`))
+		}
+	})
+	defer srv.Close()
+
+	it := r.List()
+	assert.Equal(0, pages, "Pages should only be fetched as they're needed")
+	assert.True(it.Next())
+	assert.Equal("t-1", it.Value().ID)
+	assert.Equal(1, pages)
+
+	all, err := r.ListAll()
+	assert.NoError(err)
+	assert.Len(all, 3, "Empty pages should be skipped")
+	assert.Equal("t-3", all[2].ID)
+
+	page, err := r.ListPage("b")
+	assert.NoError(err)
+	assert.Equal("ThingList", page.Kind)
+	assert.Equal("", page.Metadata.NextPageToken())
+}
+
+func TestResourceListError(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, r := newTestResource(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page_token") == "" {
+			w.Write([]byte(`{"metadata":{"next":"https://example.com/test/v2/things?page_token=a"},"data":[{"id":"t-1"}]}`))
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"errors":[{"status":"500","detail":"Oops"}]}`))
+	})
+	defer srv.Close()
+
+	all, err := r.ListAll()
+	assert.Len(all, 1, "Resources listed before the error should be returned")
+	assert.Error(err)
+
+	apiErr := Error{}
+	assert.True(errors.As(err, &apiErr))
+	assert.Equal(http.StatusInternalServerError, apiErr.HTTPStatusCode)
+}
+
+func TestResourceListRepeatedToken(t *testing.T) {
+	assert := assert.New(t)
+
+	pages := 0
+	srv, r := newTestResource(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		w.Write([]byte(`{"metadata":{"next":"https://example.com/test/v2/things?page_token=a"},"data":[{"id":"t-1"}]}`))
+	})
+	defer srv.Close()
+
+	all, err := r.ListAll()
+	assert.Error(err, "A page returning its own token should stop the iteration")
+	assert.Len(all, 1, "The repeated page shouldn't be listed")
+	assert.Equal(2, pages, "Pages should stop being fetched once a token repeats")
+}
+
+func TestResourceCRUD(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, r := newTestResource(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("env-1", r.URL.Query().Get("environment"))
+		body, _ := ioutil.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.Path {
+		case "POST /test/v2/things":
+			assert.JSONEq(`{"display_name":"a"}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"t-1","display_name":"a"}`))
+		case "GET /test/v2/things/t-1":
+			w.Write([]byte(`{"id":"t-1","display_name":"a"}`))
+		case "PATCH /test/v2/things/t-1":
+			assert.JSONEq(`{"display_name":"b"}`, string(body))
+			w.Write([]byte(`{"id":"t-1","display_name":"b"}`))
+		case "DELETE /test/v2/things/t-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	thing, err := r.Create(map[string]string{"display_name": "a"})
+	assert.NoError(err)
+	assert.Equal("t-1", thing.ID)
+
+	thing, err = r.Get("t-1")
+	assert.NoError(err)
+	assert.Equal("a", thing.DisplayName)
+
+	thing, err = r.Update("t-1", map[string]string{"display_name": "b"})
+	assert.NoError(err)
+	assert.Equal("b", thing.DisplayName)
+
+	assert.NoError(r.Delete("t-1"))
+
+	_, err = r.Get("t-2")
+	assert.Error(err)
+}
@@ -0,0 +1,46 @@
+package response
+
+import (
+	"net/url"
+	"time"
+)
+
+//ListMetadata is the pagination metadata of the Confluent Cloud v2 list APIs
+type ListMetadata struct {
+	First     string `json:"first,omitempty"`
+	Last      string `json:"last,omitempty"`
+	Prev      string `json:"prev,omitempty"`
+	Next      string `json:"next,omitempty"`
+	TotalSize int    `json:"total_size,omitempty"`
+}
+
+//NextPageToken returns the `page_token` of the next page of results, or an empty string when there are no more pages
+func (m ListMetadata) NextPageToken() string {
+	if m.Next == "" {
+		return ""
+	}
+	next, err := url.Parse(m.Next)
+	if err != nil {
+		return ""
+	}
+	return next.Query().Get("page_token")
+}
+
+//ObjectMetadata is the metadata of a single resource of the Confluent Cloud v2 APIs
+type ObjectMetadata struct {
+	Self         string     `json:"self,omitempty"`
+	ResourceName string     `json:"resource_name,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+	DeletedAt    *time.Time `json:"deleted_at,omitempty"`
+}
+
+//ObjectReference is a reference to a related resource of the Confluent Cloud v2 APIs, such as the Environment a Cluster belongs to
+type ObjectReference struct {
+	ID           string `json:"id"`
+	Environment  string `json:"environment,omitempty"`
+	Related      string `json:"related,omitempty"`
+	ResourceName string `json:"resource_name,omitempty"`
+	APIVersion   string `json:"api_version,omitempty"`
+	Kind         string `json:"kind,omitempty"`
+}
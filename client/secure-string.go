@@ -1,11 +1,42 @@
 package client
 
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+//SecureMask is what a SecurePassword is rendered as whenever it's printed, logged or marshaled
+const SecureMask string = "****SECURE****"
+
+//SecurePassword is a secret, such as an API Secret, that is masked whenever it's printed, logged or marshaled. Use Value to access the secret itself
 type SecurePassword string
 
 func (s SecurePassword) String() string {
-	return "****SECURE****"
+	return SecureMask
+}
+
+//GoString masks the secret when printed with the `%#v` verb
+func (s SecurePassword) GoString() string {
+	return SecureMask
+}
+
+//MarshalJSON masks the secret, so that it isn't leaked when the struct holding it is marshaled for logging
+func (s SecurePassword) MarshalJSON() ([]byte, error) {
+	return json.Marshal(SecureMask)
 }
 
+//Value returns the secret itself
 func (s SecurePassword) Value() string {
 	return string(s)
 }
+
+//redactedFields are the JSON fields whose values are masked when request and response bodies are logged
+var redactedFields = []string{"secret", "api_secret", "password"}
+
+var redactPattern = regexp.MustCompile(`"(` + strings.Join(redactedFields, "|") + `)"\s*:\s*"(?:[^"\\]|\\.)*"`)
+
+//Redact masks the values of any secret fields within a JSON body, so it's safe to log
+func Redact(body []byte) string {
+	return redactPattern.ReplaceAllString(string(body), `"$1":"`+SecureMask+`"`)
+}
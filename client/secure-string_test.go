@@ -0,0 +1,35 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurePassword(t *testing.T) {
+	assert := assert.New(t)
+
+	s := struct {
+		Key    string         `json:"key"`
+		Secret SecurePassword `json:"secret"`
+	}{Key: "KEY", Secret: SecurePassword("hunter2")}
+
+	assert.Equal("hunter2", s.Secret.Value())
+	assert.NotContains(fmt.Sprintf("%v %+v %#v %s", s, s, s, s.Secret), "hunter2", "Printing should mask the secret")
+
+	js, err := json.Marshal(s)
+	assert.NoError(err)
+	assert.JSONEq(`{"key":"KEY","secret":"****SECURE****"}`, string(js), "Marshaling should mask the secret")
+
+	assert.NoError(json.Unmarshal([]byte(`{"key":"KEY","secret":"hunter2"}`), &s))
+	assert.Equal("hunter2", s.Secret.Value(), "Unmarshaling should keep the secret")
+}
+
+func TestRedact(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(`{"id":"KEY","spec":{"secret":"****SECURE****","display_name":"ci"}}`, Redact([]byte(`{"id":"KEY","spec":{"secret":"abc\"123","display_name":"ci"}}`)))
+	assert.Equal(`{"password":"****SECURE****"}`, Redact([]byte(`{"password" : "hunter2"}`)))
+}
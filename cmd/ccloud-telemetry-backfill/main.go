@@ -0,0 +1,137 @@
+//ccloud-telemetry-backfill fetches the history of metrics for a set of resources, writing it out to date partitioned files.
+//
+//Runs are restartable. Chunks already written are skipped and failed chunks, recorded to _failures.jsonl, are retried by re-running the same command.
+//
+//	ccloud-telemetry-backfill -resources lkc-1,lkc-2 -metrics received_bytes,sent_bytes -group-by metric.topic,metric.partition \
+//		-granularity PT1H -start 2021-01-01 -end 2021-04-01 -out ./history
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/backfill"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/resourcetype"
+)
+
+func main() {
+	apiKey := flag.String("api-key", os.Getenv("CCLOUD_API_KEY"), "Cloud API Key. Defaults to $CCLOUD_API_KEY")
+	apiSecret := flag.String("api-secret", os.Getenv("CCLOUD_API_SECRET"), "Cloud API Secret. Defaults to $CCLOUD_API_SECRET")
+	resourceType := flag.String("resource-type", resourcetype.ResourceTypeKafka.Type, "Type of the resources to backfill")
+	resources := flag.String("resources", "", "Comma separated list of resource IDs to backfill")
+	metrics := flag.String("metrics", "", "Comma separated list of metric names to backfill")
+	groupBy := flag.String("group-by", "", "Comma separated list of metric labels to group by, such as metric.topic,metric.partition")
+	gran := flag.String("granularity", granularity.OneHour.String(), "Granularity to aggregate data points up to")
+	start := flag.String("start", "", "Start of the date range, as a date (2006-01-02) or RFC3339 timestamp")
+	end := flag.String("end", "", "End of the date range, as a date (2006-01-02) or RFC3339 timestamp. Defaults to now")
+	out := flag.String("out", "backfill", "Directory to write the date partitioned files to")
+	format := flag.String("format", string(backfill.FormatJSONL), "Output file format. Either jsonl or csv")
+	concurrency := flag.Int("concurrency", backfill.DefaultConcurrency, "Number of chunks to fetch at the same time")
+	rps := flag.Float64("rps", backfill.DefaultRequestsPerSecond, "Max number of requests per second to send to the API")
+	flag.Parse()
+
+	job, err := buildJob(*resourceType, *resources, *metrics, *groupBy, *gran, *start, *end)
+	if err == nil && (*apiKey == "" || *apiSecret == "") {
+		err = fmt.Errorf("An API Key and Secret are required")
+	}
+	fileFormat, formatErr := backfill.ParseFormat(*format)
+	if err == nil {
+		err = formatErr
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	client := telemetry.New(*apiKey, *apiSecret)
+	b := backfill.New(&client, *out, fileFormat)
+	b.Concurrency = *concurrency
+	b.RequestsPerSecond = *rps
+
+	summary, err := b.Run(ctx, job)
+	fmt.Printf("Chunks: %d, Skipped: %d, Written: %d, Failed: %d\n", summary.Chunks, summary.Skipped, summary.Written, len(summary.Failures))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if len(summary.Failures) > 0 {
+		os.Exit(1)
+	}
+}
+
+func buildJob(resourceType string, resources string, metrics string, groupBy string, gran string, start string, end string) (backfill.Job, error) {
+	job := backfill.Job{}
+
+	rt, ok := resourcetype.Lookup(resourceType)
+	if !ok || len(rt.Labels) <= 0 {
+		return job, fmt.Errorf("Unknown resource type `%s`", resourceType)
+	}
+	job.Resource = rt.Labels[0]
+	job.ResourceIDs = splitList(resources)
+
+	for _, name := range splitList(metrics) {
+		m, ok := metric.Lookup(name)
+		if !ok {
+			m = metric.New(name)
+		}
+		job.Metrics = append(job.Metrics, m)
+	}
+
+	for _, key := range splitList(groupBy) {
+		if !strings.HasPrefix(key, "metric.") {
+			key = "metric." + key
+		}
+		job.GroupBy = append(job.GroupBy, labels.NewMetric(key))
+	}
+
+	var err error
+	if job.Granularity, err = granularity.Parse(gran); err != nil {
+		return job, err
+	}
+	if job.Start, err = parseTime(start); err != nil {
+		return job, err
+	}
+	job.End = time.Now()
+	if end != "" {
+		if job.End, err = parseTime(end); err != nil {
+			return job, err
+		}
+	}
+
+	return job, job.Validate()
+}
+
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func splitList(value string) []string {
+	values := []string{}
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,388 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/resourcetype"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+)
+
+//DefaultInterval is the window of time, ending now, used when no interval is given
+const DefaultInterval string = "1h"
+
+//command is a single sub command of the CLI
+type command struct {
+	name string
+	desc string
+	run  func(args []string, out io.Writer) error
+}
+
+//commonFlags are the flags shared by all the sub commands
+type commonFlags struct {
+	config configFlags
+	output *string
+}
+
+//stringList is a repeatable flag
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func newFlagSet(name string) (*flag.FlagSet, commonFlags) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	return fs, commonFlags{
+		config: addConfigFlags(fs),
+		output: fs.String("output", OutputTable, "Output format. One of table, json, csv or prometheus"),
+	}
+}
+
+func runResources(args []string, out io.Writer) error {
+	fs, common := newFlagSet("resources")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	config, err := common.config.load()
+	if err != nil {
+		return err
+	}
+
+	resources, err := config.client().GetAvailableResources()
+	if err != nil {
+		return err
+	}
+
+	table := Table{Headers: []string{"type", "description", "labels"}}
+	for _, r := range resources {
+		lbls := make([]string, len(r.Labels))
+		for i, l := range r.Labels {
+			lbls[i] = labels.Key(l)
+		}
+		table.Rows = append(table.Rows, []string{r.Type, r.Desc, strings.Join(lbls, ",")})
+	}
+	return writeOutput(out, *common.output, resources, table)
+}
+
+func runMetrics(args []string, out io.Writer) error {
+	fs, common := newFlagSet("metrics")
+	resourceType := fs.String("resource-type", resourcetype.ResourceTypeKafka.Type, "Type of resource to list the available metrics of")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	config, err := common.config.load()
+	if err != nil {
+		return err
+	}
+
+	rt, ok := resourcetype.Lookup(*resourceType)
+	if !ok {
+		rt = resourcetype.ResourceType{Type: *resourceType}
+	}
+
+	metrics, err := config.client().GetAvailableMetricsForResource(rt)
+	if err != nil {
+		return err
+	}
+
+	table := Table{Headers: []string{"name", "type", "unit", "labels", "description"}}
+	for _, m := range metrics {
+		lbls := make([]string, len(m.Labels))
+		for i, l := range m.Labels {
+			lbls[i] = l.Key
+		}
+		table.Rows = append(table.Rows, []string{m.Name, m.Type, string(m.Unit), strings.Join(lbls, ","), m.Desc})
+	}
+	return writeOutput(out, *common.output, metrics, table)
+}
+
+func runLabels(args []string, out io.Writer) error {
+	fs, common := newFlagSet("labels")
+	resourceType := fs.String("resource-type", resourcetype.ResourceTypeKafka.Type, "Type of the resource to lookup label values for")
+	resource := fs.String("resource", "", "ID of the resource to lookup label values for, such as lkc-1234")
+	metricName := fs.String("metric", metric.KafkaServerRetainedBytes.Name, "Metric to lookup label values with")
+	label := fs.String("label", labels.MetricTopic.Key, "Comma separated list of labels to lookup the values, or tuples of values, of")
+	inter := fs.String("interval", DefaultInterval, "ISO-8601 interval, or a duration ending now such as 1h")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	config, err := common.config.load()
+	if err != nil {
+		return err
+	}
+
+	q := telemetry.LabelValuesQuery{
+		Metric: lookupMetric(*metricName),
+	}
+	if q.Filter, err = resourceFilter(*resourceType, *resource); err != nil {
+		return err
+	}
+	if q.Interval, err = parseInterval(*inter, time.Now()); err != nil {
+		return err
+	}
+	headers := []string{}
+	for _, l := range splitList(*label) {
+		lbl := parseLabel(l)
+		q.GroupBy = append(q.GroupBy, lbl)
+		headers = append(headers, labels.Key(lbl))
+	}
+	if len(q.GroupBy) <= 0 {
+		return errors.New("At least 1 label is required")
+	}
+
+	tuples, err := config.client().LabelValues(q)
+	if err != nil {
+		return err
+	}
+
+	table := Table{Headers: headers}
+	raw := make([]map[string]string, len(tuples))
+	for i, t := range tuples {
+		table.Rows = append(table.Rows, t.Strings())
+		raw[i] = map[string]string{}
+		for _, v := range t {
+			raw[i][v.Key] = v.String()
+		}
+	}
+	return writeOutput(out, *common.output, raw, table)
+}
+
+func runQuery(args []string, out io.Writer) error {
+	fs, common := newFlagSet("query")
+	metricName := fs.String("metric", "", "Metric to query, such as io.confluent.kafka.server/received_bytes or received_bytes")
+	resourceType := fs.String("resource-type", resourcetype.ResourceTypeKafka.Type, "Type of the resource to query")
+	resource := fs.String("resource", "", "Comma separated list of IDs of the resources to query")
+	groupBy := fs.String("group-by", "", "Comma separated list of labels to group by, such as metric.topic,metric.partition")
+	gran := fs.String("granularity", "", "Granularity to aggregate data points up to. Defaults to the smallest the interval allows")
+	inter := fs.String("interval", DefaultInterval, "ISO-8601 interval, or a duration ending now such as 1h")
+	limit := fs.Int("limit", 0, "Max number of data points to return, fetching as many pages as needed. Defaults to every data point")
+	queryFile := fs.String("query-file", "", "Path to a JSON metric query, in the form sent to the API. All other query flags, except -limit, are ignored")
+	filters := stringList{}
+	fs.Var(&filters, "filter", "Filter, as label=value, label!=value, label>value, label>=value, label<value or label<=value. Can be repeated, all must match")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	config, err := common.config.load()
+	if err != nil {
+		return err
+	}
+	client := config.client()
+
+	var q query.Query
+	if *queryFile != "" {
+		js, err := ioutil.ReadFile(*queryFile)
+		if err != nil {
+			return err
+		}
+		if q, err = query.Unmarshal(js); err != nil {
+			return fmt.Errorf("Invalid query file `%s`: %s", *queryFile, err)
+		}
+	} else {
+		if q, err = buildQuery(*metricName, *resourceType, *resource, filters, *groupBy, *gran, *inter, time.Now()); err != nil {
+			return err
+		}
+	}
+	if q.Limit <= 0 {
+		q.Limit = client.PageLimit
+	}
+	if *limit > 0 && (q.Limit <= 0 || *limit < q.Limit) {
+		q.Limit = *limit
+	}
+
+	res, err := fetchPages(*limit, func(pageToken string) (response.Query, error) {
+		return client.PostMetricsQueryPage(q, pageToken)
+	})
+	if err != nil {
+		return err
+	}
+
+	if *common.output == OutputPrometheus {
+		return writePrometheus(out, q.Metric.Name, res.Data)
+	}
+	return writeOutput(out, *common.output, telemetryRecords(res.Data), telemetryTable(res.Data))
+}
+
+//fetchPages fetches pages of results, following the next page tokens until there are no more pages.
+//When max is above 0, paging stops as soon as max data points have been fetched, and the results are trimmed to max.
+func fetchPages(max int, page func(pageToken string) (response.Query, error)) (response.Query, error) {
+	all := response.Query{}
+	pageToken := ""
+	for {
+		res, err := page(pageToken)
+		if err != nil {
+			return response.Query{}, err
+		}
+		all.Data = append(all.Data, res.Data...)
+		if max > 0 && len(all.Data) >= max {
+			all.Data = all.Data[:max]
+			return all, nil
+		}
+
+		next := res.NextPageToken()
+		if next == "" {
+			return all, nil
+		}
+		if next == pageToken {
+			return response.Query{}, fmt.Errorf("Page token `%s` was returned twice", next)
+		}
+		pageToken = next
+	}
+}
+
+//buildQuery creates a metric query from the query command's flags
+func buildQuery(metricName string, resourceType string, resources string, filters []string, groupBy string, gran string, inter string, now time.Time) (query.Query, error) {
+	q := query.Query{}
+	if metricName == "" {
+		return q, errors.New("A metric is required")
+	}
+	q.Metric = lookupMetric(metricName)
+
+	i, err := parseInterval(inter, now)
+	if err != nil {
+		return q, err
+	}
+	q.Intervals = interval.Of(i)
+
+	q.Granularity = i.MinGranularity()
+	if gran != "" {
+		if q.Granularity, err = granularity.Parse(gran); err != nil {
+			return q, err
+		}
+	}
+
+	all := []filter.Filter{}
+	if resources != "" {
+		fil, err := resourceFilter(resourceType, resources)
+		if err != nil {
+			return q, err
+		}
+		all = append(all, fil)
+	}
+	for _, f := range filters {
+		fil, err := parseFilter(f)
+		if err != nil {
+			return q, err
+		}
+		all = append(all, fil)
+	}
+	if len(all) == 1 {
+		q.Filter = all[0]
+	} else if len(all) > 1 {
+		q.Filter = filter.And(all...)
+	}
+
+	for _, l := range splitList(groupBy) {
+		q.GroupBy = q.GroupBy.And(parseLabel(l))
+	}
+	q.Aggregations = agg.Of(agg.DefaultOf(q.Metric, q.GroupBy.Labels...))
+
+	return q, q.Validate()
+}
+
+//resourceFilter creates a filter matching any of the comma separated resource IDs of the given resource type
+func resourceFilter(resourceType string, resources string) (filter.Filter, error) {
+	rt, ok := resourcetype.Lookup(resourceType)
+	if !ok || len(rt.Labels) <= 0 {
+		return nil, fmt.Errorf("Unknown resource type `%s`", resourceType)
+	}
+
+	ids := splitList(resources)
+	if len(ids) <= 0 {
+		return nil, errors.New("At least 1 resource ID is required")
+	}
+	if len(ids) == 1 {
+		return filter.EqualTo(rt.Labels[0], ids[0]), nil
+	}
+
+	fils := make([]filter.Filter, len(ids))
+	for i, id := range ids {
+		fils[i] = filter.EqualTo(rt.Labels[0], id)
+	}
+	return filter.Or(fils...), nil
+}
+
+//parseFilter parses a filter in the form of label=value, label!=value, label>value, label>=value, label<value or label<=value.
+//The filter is split at the first operator, so values may contain operator characters of their own.
+func parseFilter(value string) (filter.Filter, error) {
+	invalid := fmt.Errorf("Invalid filter `%s`. Expected one of label=value, label!=value, label>value, label>=value, label<value or label<=value", value)
+
+	idx := strings.IndexAny(value, "!=<>")
+	if idx <= 0 {
+		return nil, invalid
+	}
+	op := value[idx : idx+1]
+	if op != "=" && strings.HasPrefix(value[idx+1:], "=") {
+		op += "="
+	}
+
+	field := parseLabel(strings.TrimSpace(value[:idx]))
+	v := strings.TrimSpace(value[idx+len(op):])
+	switch op {
+	case "!=":
+		return filter.NotEqualTo(field, v), nil
+	case ">=":
+		return filter.GreaterThanOrEqualTo(field, v), nil
+	case "<=":
+		return filter.LessThanOrEqualTo(field, v), nil
+	case ">":
+		return filter.GreaterThan(field, v), nil
+	case "<":
+		return filter.LessThan(field, v), nil
+	case "=":
+		return filter.EqualTo(field, v), nil
+	default:
+		return nil, invalid
+	}
+}
+
+//parseLabel parses a label key. Keys prefixed with `resource.` are Resource labels, all others are Metric labels
+func parseLabel(key string) labels.Label {
+	if !strings.HasPrefix(key, "resource.") && !strings.HasPrefix(key, "metric.") {
+		key = "metric." + key
+	}
+	return labels.FromKey(key)
+}
+
+//parseInterval parses an ISO-8601 interval, or a Go duration such as 1h that ends at the given time
+func parseInterval(value string, now time.Time) (interval.Interval, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return interval.EndingAt(d, now), nil
+	}
+	return interval.Parse(value)
+}
+
+//lookupMetric finds a known metric by its name or short name, falling back to an untyped metric of that name
+func lookupMetric(name string) metric.Metric {
+	if m, ok := metric.Lookup(name); ok {
+		return m
+	}
+	return metric.New(name)
+}
+
+func splitList(value string) []string {
+	values := []string{}
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
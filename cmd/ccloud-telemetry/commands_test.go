@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFilter(t *testing.T) {
+	assert := assert.New(t)
+
+	f, err := parseFilter("topic=orders")
+	assert.NoError(err)
+	assert.Equal(filter.EqualTo(labels.MetricTopic, "orders"), f, "Short label names should be metric labels")
+
+	f, err = parseFilter("resource.kafka.id!=lkc-1")
+	assert.NoError(err)
+	assert.Equal(filter.NotEqualTo(labels.ResourceKafka, "lkc-1"), f)
+
+	f, err = parseFilter("metric.partition>=10")
+	assert.NoError(err)
+	assert.Equal(filter.GreaterThanOrEqualTo(labels.MetricPartition, "10"), f)
+
+	f, err = parseFilter("metric.partition>10")
+	assert.NoError(err)
+	assert.Equal(filter.GreaterThan(labels.MetricPartition, "10"), f)
+
+	f, err = parseFilter("metric.partition<=10")
+	assert.NoError(err)
+	assert.Equal(filter.LessThanOrEqualTo(labels.MetricPartition, "10"), f)
+
+	f, err = parseFilter("metric.partition<10")
+	assert.NoError(err)
+	assert.Equal(filter.LessThan(labels.MetricPartition, "10"), f)
+
+	f, err = parseFilter("metric.topic=a>b")
+	assert.NoError(err)
+	assert.Equal(filter.EqualTo(labels.MetricTopic, "a>b"), f, "The filter should be split at the first operator")
+
+	f, err = parseFilter("metric.topic!=a<=b")
+	assert.NoError(err)
+	assert.Equal(filter.NotEqualTo(labels.MetricTopic, "a<=b"), f)
+
+	_, err = parseFilter("metric.topic!orders")
+	assert.Error(err, "A bare ! is not an operator")
+	_, err = parseFilter("metric.topic")
+	assert.Error(err, "A filter without an operator should error")
+	_, err = parseFilter("=orders")
+	assert.Error(err, "A filter without a label should error")
+}
+
+func TestBuildQuery(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2021, 4, 20, 16, 15, 0, 0, time.UTC)
+
+	q, err := buildQuery("received_bytes", "kafka", "lkc-1,lkc-2", []string{"topic=orders"}, "topic,partition", "PT1H", "6h", now)
+	assert.NoError(err)
+	assert.Equal(metric.KafkaServerReceivedBytes.Name, q.Metric.Name)
+	assert.Equal(granularity.OneHour, q.Granularity)
+	assert.Equal("2021-04-20T10:15:00Z/PT6H", q.Intervals[0].String())
+	assert.Equal([]labels.Label{labels.MetricTopic, labels.MetricPartition}, q.GroupBy.Labels)
+	assert.Equal(filter.And(
+		filter.Or(filter.EqualTo(labels.ResourceKafka, "lkc-1"), filter.EqualTo(labels.ResourceKafka, "lkc-2")),
+		filter.EqualTo(labels.MetricTopic, "orders"),
+	), q.Filter)
+
+	q, err = buildQuery("received_bytes", "kafka", "lkc-1", nil, "", "", "1h", now)
+	assert.NoError(err)
+	assert.Equal(granularity.OneMin, q.Granularity, "Granularity should default to the smallest the interval allows")
+	assert.Equal(filter.EqualTo(labels.ResourceKafka, "lkc-1"), q.Filter)
+
+	_, err = buildQuery("", "kafka", "lkc-1", nil, "", "", "1h", now)
+	assert.Error(err, "A metric is required")
+	_, err = buildQuery("received_bytes", "unknown", "lkc-1", nil, "", "", "1h", now)
+	assert.Error(err, "Unknown resource types should error")
+}
+
+func TestFetchPages(t *testing.T) {
+	assert := assert.New(t)
+
+	pages := map[string]response.Query{}
+	for i := 0; i < 3; i++ {
+		page := response.Query{BaseResponse: &response.BaseResponse{}, Data: []response.Telemetry{{Value: float64(i * 2)}, {Value: float64(i*2 + 1)}}}
+		if i < 2 {
+			page.Meta.Pagination.NextPageToken = strconv.Itoa(i + 1)
+		}
+		token := ""
+		if i > 0 {
+			token = strconv.Itoa(i)
+		}
+		pages[token] = page
+	}
+	fetched := []string{}
+	page := func(pageToken string) (response.Query, error) {
+		fetched = append(fetched, pageToken)
+		return pages[pageToken], nil
+	}
+
+	res, err := fetchPages(0, page)
+	assert.NoError(err)
+	assert.Len(res.Data, 6, "Every page should be fetched without a max")
+	assert.Equal([]string{"", "1", "2"}, fetched)
+
+	fetched = []string{}
+	res, err = fetchPages(3, page)
+	assert.NoError(err)
+	assert.Len(res.Data, 3, "A max larger than a page should span pages")
+	assert.Equal(float64(2), res.Data[2].Value)
+	assert.Equal([]string{"", "1"}, fetched, "Paging should stop once the max is reached")
+
+	_, err = fetchPages(0, func(pageToken string) (response.Query, error) {
+		if pageToken != "" {
+			return response.Query{}, errors.New("failed")
+		}
+		return pages[pageToken], nil
+	})
+	assert.Error(err, "A failed page should fail the whole query")
+}
+
+func TestWritePrometheus(t *testing.T) {
+	assert := assert.New(t)
+
+	out := bytes.Buffer{}
+	err := writePrometheus(&out, metric.KafkaServerReceivedBytes.Name, []response.Telemetry{
+		{
+			Timestamp: time.Unix(1618935300, 0),
+			Value:     1024,
+			Fields:    map[string]interface{}{"resource.kafka.id": "lkc-1", "metric.topic": "orders"},
+		},
+		{
+			Timestamp: time.Unix(1618935360, 0),
+			Value:     2.5,
+			Fields:    map[string]interface{}{"resource.kafka.id": "lkc-1", "metric.topic": "orders"},
+		},
+	})
+	assert.NoError(err)
+	assert.Equal(`# TYPE io_confluent_kafka_server_received_bytes gauge
+io_confluent_kafka_server_received_bytes{metric_topic="orders",resource_kafka_id="lkc-1"} 1024 1618935300000
+io_confluent_kafka_server_received_bytes{metric_topic="orders",resource_kafka_id="lkc-1"} 2.5 1618935360000
+`, out.String())
+}
+
+func TestWritePrometheus_Escaping(t *testing.T) {
+	assert := assert.New(t)
+
+	out := bytes.Buffer{}
+	err := writePrometheus(&out, metric.KafkaServerReceivedBytes.Name, []response.Telemetry{
+		{
+			Value:  1,
+			Fields: map[string]interface{}{"metric.topic": "a\\b\"c\nd\té"},
+		},
+	})
+	assert.NoError(err)
+	assert.Equal("# TYPE io_confluent_kafka_server_received_bytes gauge\nio_confluent_kafka_server_received_bytes{metric_topic=\"a\\\\b\\\"c\\nd\té\"} 1\n", out.String(), "Only backslashes, double quotes and new lines should be escaped")
+}
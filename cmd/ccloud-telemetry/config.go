@@ -0,0 +1,103 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+)
+
+const (
+	//EnvAPIKey is the env var the API Key is read from when not given as a flag
+	EnvAPIKey string = "CCLOUD_API_KEY"
+	//EnvAPISecret is the env var the API Secret is read from when not given as a flag
+	EnvAPISecret string = "CCLOUD_API_SECRET"
+	//EnvConfig is the env var the config file path is read from when not given as a flag
+	EnvConfig string = "CCLOUD_TELEMETRY_CONFIG"
+	//DefaultConfigFile is the name of the config file, within the user's home directory, used when no other is given
+	DefaultConfigFile string = ".ccloud-telemetry.json"
+)
+
+//Config holds the credentials and connection settings. Flags take precedence over env vars, which take precedence over the config file.
+type Config struct {
+	APIKey    string `json:"api_key"`
+	APISecret string `json:"api_secret"`
+	BaseURL   string `json:"base_url,omitempty"`
+	Dataset   string `json:"dataset,omitempty"`
+}
+
+type configFlags struct {
+	path      *string
+	apiKey    *string
+	apiSecret *string
+	baseURL   *string
+	dataset   *string
+}
+
+func addConfigFlags(fs *flag.FlagSet) configFlags {
+	return configFlags{
+		path:      fs.String("config", os.Getenv(EnvConfig), "Path to a JSON config file. Defaults to ~/"+DefaultConfigFile),
+		apiKey:    fs.String("api-key", "", "Cloud API Key. Defaults to $"+EnvAPIKey),
+		apiSecret: fs.String("api-secret", "", "Cloud API Secret. Defaults to $"+EnvAPISecret),
+		baseURL:   fs.String("base-url", "", "Base URL of the Telemetry API. Defaults to "+telemetry.DefaultBaseURL),
+		dataset:   fs.String("dataset", "", "Dataset to query. Defaults to "+string(telemetry.DatasetCloud)),
+	}
+}
+
+func (f configFlags) load() (Config, error) {
+	config := Config{}
+
+	path := *f.path
+	explicit := path != ""
+	if !explicit {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, DefaultConfigFile)
+		}
+	}
+	if path != "" {
+		js, err := ioutil.ReadFile(path)
+		if err != nil && (explicit || !os.IsNotExist(err)) {
+			return config, err
+		}
+		if err == nil {
+			if err := json.Unmarshal(js, &config); err != nil {
+				return config, err
+			}
+		}
+	}
+
+	override(&config.APIKey, os.Getenv(EnvAPIKey), *f.apiKey)
+	override(&config.APISecret, os.Getenv(EnvAPISecret), *f.apiSecret)
+	override(&config.BaseURL, *f.baseURL)
+	override(&config.Dataset, *f.dataset)
+
+	if config.APIKey == "" || config.APISecret == "" {
+		return config, errors.New("An API Key and Secret are required. Set them with -api-key/-api-secret, $" + EnvAPIKey + "/$" + EnvAPISecret + ", or a config file")
+	}
+	return config, nil
+}
+
+//client creates a TelemetryClient from the config
+func (c Config) client() *telemetry.TelemetryClient {
+	client := telemetry.New(c.APIKey, c.APISecret)
+	if c.BaseURL != "" {
+		client.Context.BaseURL = c.BaseURL
+	}
+	if c.Dataset != "" {
+		client.DataSet = telemetry.Dataset(c.Dataset)
+	}
+	return &client
+}
+
+//override sets the value to the last none empty override
+func override(value *string, overrides ...string) {
+	for _, o := range overrides {
+		if o != "" {
+			*value = o
+		}
+	}
+}
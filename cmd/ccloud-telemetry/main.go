@@ -0,0 +1,69 @@
+//ccloud-telemetry is a command line tool for ad-hoc exploration of the Confluent Cloud Telemetry API.
+//
+//	ccloud-telemetry resources
+//	ccloud-telemetry metrics -resource-type kafka
+//	ccloud-telemetry labels -resource lkc-1234 -label metric.topic
+//	ccloud-telemetry query -resource lkc-1234 -metric received_bytes -group-by metric.topic -interval 6h -granularity PT1H
+//	ccloud-telemetry query -query-file query.json -output prometheus
+//
+//The labels command is built on TelemetryClient.LabelValues rather than LabelQuery, as LabelQuery only returns the first page of values for a single label,
+//where labels needs every page and distinct tuples when given several labels.
+//
+//Credentials are read from the -api-key/-api-secret flags, the CCLOUD_API_KEY/CCLOUD_API_SECRET env vars, or a JSON config file.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+var commands = []command{
+	{name: "resources", desc: "List the available resource types", run: runResources},
+	{name: "metrics", desc: "List the available metrics for a resource type", run: runMetrics},
+	{name: "labels", desc: "List the distinct values, or tuples of values, of one or more labels for a resource, across every page", run: runLabels},
+	{name: "query", desc: "Query a metric, or send a JSON query from a file", run: runQuery},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, out io.Writer, errOut io.Writer) int {
+	if len(args) <= 0 || args[0] == "-h" || args[0] == "-help" || args[0] == "help" {
+		usage(errOut)
+		return 2
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+
+		err := cmd.run(args[1:], out)
+		if err == flag.ErrHelp {
+			return 2
+		}
+		if err != nil {
+			fmt.Fprintln(errOut, err)
+			return 1
+		}
+		return 0
+	}
+
+	fmt.Fprintf(errOut, "Unknown command `%s`\n\n", args[0])
+	usage(errOut)
+	return 2
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: ccloud-telemetry <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.desc)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run `ccloud-telemetry <command> -h` for the flags of a command")
+}
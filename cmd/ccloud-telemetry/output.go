@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+)
+
+const (
+	//OutputTable is a static def for human readable, aligned, table output
+	OutputTable string = "table"
+	//OutputJSON is a static def for JSON output
+	OutputJSON string = "json"
+	//OutputCSV is a static def for CSV output
+	OutputCSV string = "csv"
+	//OutputPrometheus is a static def for Prometheus text exposition format output
+	OutputPrometheus string = "prometheus"
+)
+
+var (
+	promInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+	//promLabelEscaper escapes label values as the Prometheus text format expects, which only escapes backslashes, double quotes and new lines
+	promLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+)
+
+//Table is a generic tabular representation of a command's results
+type Table struct {
+	Headers []string
+	Rows    [][]string
+}
+
+//writeOutput writes the results of a command out in the given format.
+//The raw value is used for JSON output, where as the Table is used for table and CSV output.
+func writeOutput(w io.Writer, format string, raw interface{}, table Table) error {
+	switch format {
+	case OutputTable, "":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(table.Headers, "\t")))
+		for _, row := range table.Rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	case OutputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(raw)
+	case OutputCSV:
+		out := csv.NewWriter(w)
+		if err := out.Write(table.Headers); err != nil {
+			return err
+		}
+		if err := out.WriteAll(table.Rows); err != nil {
+			return err
+		}
+		return out.Error()
+	case OutputPrometheus:
+		return fmt.Errorf("The `%s` output is only supported by the query command", OutputPrometheus)
+	default:
+		return fmt.Errorf("Unknown output `%s`. Expected one of table, json, csv or prometheus", format)
+	}
+}
+
+//telemetryTable converts query results into a Table, with a column per label found across all the data points
+func telemetryTable(data []response.Telemetry) Table {
+	keys := fieldKeys(data)
+	table := Table{
+		Headers: append([]string{"timestamp", "metric", "value"}, keys...),
+	}
+	for _, d := range data {
+		row := []string{
+			formatTimestamp(d.Timestamp),
+			d.Metric,
+			strconv.FormatFloat(d.Value, 'f', -1, 64),
+		}
+		for _, k := range keys {
+			row = append(row, response.LabelValue{Key: k, Raw: d.Fields[k]}.String())
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
+
+//telemetryRecords converts query results into flat records for JSON output
+func telemetryRecords(data []response.Telemetry) []map[string]interface{} {
+	records := make([]map[string]interface{}, len(data))
+	for i, d := range data {
+		record := map[string]interface{}{}
+		for k, v := range d.Fields {
+			record[k] = v
+		}
+		if !d.Timestamp.IsZero() {
+			record["timestamp"] = d.Timestamp
+		}
+		if d.Metric != "" {
+			record["metric"] = d.Metric
+		}
+		record["value"] = d.Value
+		records[i] = record
+	}
+	return records
+}
+
+//writePrometheus writes query results out in the Prometheus text exposition format.
+//The metric name is used for data points that don't carry their own.
+func writePrometheus(w io.Writer, metricName string, data []response.Telemetry) error {
+	typed := map[string]bool{}
+	keys := fieldKeys(data)
+	for _, d := range data {
+		name := d.Metric
+		if name == "" {
+			name = metricName
+		}
+		name = promName(name)
+		if !typed[name] {
+			typed[name] = true
+			if _, err := fmt.Fprintf(w, "# TYPE %s gauge\n", name); err != nil {
+				return err
+			}
+		}
+
+		lbls := []string{}
+		for _, k := range keys {
+			if v, ok := d.Fields[k]; ok {
+				value := response.LabelValue{Key: k, Raw: v}.String()
+				lbls = append(lbls, fmt.Sprintf(`%s="%s"`, promName(k), promLabelEscaper.Replace(value)))
+			}
+		}
+
+		line := name
+		if len(lbls) > 0 {
+			line += "{" + strings.Join(lbls, ",") + "}"
+		}
+		line += " " + strconv.FormatFloat(d.Value, 'f', -1, 64)
+		if !d.Timestamp.IsZero() {
+			line += " " + strconv.FormatInt(d.Timestamp.UnixNano()/int64(time.Millisecond), 10)
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func promName(name string) string {
+	if name == "" {
+		name = "value"
+	}
+	name = promInvalidChars.ReplaceAllString(name, "_")
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+func fieldKeys(data []response.Telemetry) []string {
+	seen := map[string]bool{}
+	keys := []string{}
+	for _, d := range data {
+		for k := range d.Fields {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
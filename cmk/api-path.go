@@ -0,0 +1,35 @@
+package cmk
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+)
+
+const (
+	APIPathClusters CMKAPIPath = "cmk/v%d/clusters"
+)
+
+//CMKAPIPath is a path of the CMK API, versioned within the path such as `cmk/v2/clusters`
+type CMKAPIPath client.APIPath
+
+//Format builds the full URL of the path, scoped to the given Environment, with any IDs appended as sub paths
+func (p CMKAPIPath) Format(client CMKClient, apiVersion int8, environment string, ids ...string) string {
+	return p.unscoped(client, apiVersion, ids...) + "?" + environmentParams(environment).Encode()
+}
+
+//unscoped builds the full URL of the path, without the Environment param
+func (p CMKAPIPath) unscoped(client CMKClient, apiVersion int8, ids ...string) string {
+	parts := []string{client.Context.ResolveBaseURL(), fmt.Sprintf(string(p), apiVersion)}
+	for _, id := range ids {
+		parts = append(parts, url.PathEscape(id))
+	}
+	return strings.Join(parts, "/")
+}
+
+//environmentParams are the params that scope a request to an Environment
+func environmentParams(environment string) url.Values {
+	return url.Values{"environment": {environment}}
+}
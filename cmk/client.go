@@ -0,0 +1,39 @@
+package cmk
+
+import (
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/authenticater"
+)
+
+const (
+	//DefaultBaseURL is the default Domain and Protocol for the Confluent Cloud APIs
+	DefaultBaseURL string = client.DefaultCloudBaseURL
+	//DefaultPageSize is the default number of results to request per page when listing
+	DefaultPageSize int = 100
+	//DefaultPollInterval is the default initial wait between polls of WaitForProvisioned
+	DefaultPollInterval time.Duration = 10 * time.Second
+	//DefaultMaxPollInterval is the default upper bound the wait between polls of WaitForProvisioned backs off to
+	DefaultMaxPollInterval time.Duration = 2 * time.Minute
+)
+
+//CMKClient is the SDK Client for managing Confluent Cloud Kafka Clusters
+type CMKClient struct {
+	client.Client
+	PageSize        int
+	PollInterval    time.Duration
+	MaxPollInterval time.Duration
+}
+
+//New Used to create a new CMKClient from a Cloud API Key and Secret
+func New(apiKey string, apiSecret string) CMKClient {
+	c := CMKClient{
+		PageSize:        DefaultPageSize,
+		PollInterval:    DefaultPollInterval,
+		MaxPollInterval: DefaultMaxPollInterval,
+		Client:          client.New(authenticater.NewAPIKeyAuth(apiKey, apiSecret), DefaultBaseURL, client.ErrorResponseHandler),
+	}
+	c.Context.Endpoint = client.Endpoint{Service: client.ServiceCloud}
+	return c
+}
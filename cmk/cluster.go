@@ -0,0 +1,153 @@
+package cmk
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+)
+
+const (
+	//KindCluster is the kind of Cluster resources
+	KindCluster string = "Cluster"
+
+	//KindBasic is a Basic, multi-tenant, Cluster config
+	KindBasic string = "Basic"
+	//KindStandard is a Standard, multi-tenant, Cluster config
+	KindStandard string = "Standard"
+	//KindDedicated is a Dedicated Cluster config, sized in CKUs
+	KindDedicated string = "Dedicated"
+
+	//AvailabilitySingleZone places a Cluster within a single availability zone
+	AvailabilitySingleZone string = "SINGLE_ZONE"
+	//AvailabilityMultiZone spreads a Cluster across multiple availability zones
+	AvailabilityMultiZone string = "MULTI_ZONE"
+
+	//PhaseProvisioning is the phase of a Cluster that is still being created or resized
+	PhaseProvisioning string = "PROVISIONING"
+	//PhaseProvisioned is the phase of a Cluster that is ready for use
+	PhaseProvisioned string = "PROVISIONED"
+	//PhaseFailed is the phase of a Cluster that failed to provision
+	PhaseFailed string = "FAILED"
+)
+
+//Cluster is a Confluent Cloud Kafka Cluster
+type Cluster struct {
+	APIVersion string                  `json:"api_version,omitempty"`
+	Kind       string                  `json:"kind,omitempty"`
+	ID         string                  `json:"id,omitempty"`
+	Metadata   response.ObjectMetadata `json:"metadata,omitempty"`
+	Spec       ClusterSpec             `json:"spec"`
+	Status     ClusterStatus           `json:"status,omitempty"`
+}
+
+//ClusterSpec is the desired state of a Cluster
+type ClusterSpec struct {
+	DisplayName            string                    `json:"display_name,omitempty"`
+	Availability           string                    `json:"availability,omitempty"`
+	Cloud                  string                    `json:"cloud,omitempty"`
+	Region                 string                    `json:"region,omitempty"`
+	Config                 *ClusterConfig            `json:"config,omitempty"`
+	Environment            *response.ObjectReference `json:"environment,omitempty"`
+	Network                *response.ObjectReference `json:"network,omitempty"`
+	KafkaBootstrapEndpoint string                    `json:"kafka_bootstrap_endpoint,omitempty"`
+	HTTPEndpoint           string                    `json:"http_endpoint,omitempty"`
+	APIEndpoint            string                    `json:"api_endpoint,omitempty"`
+}
+
+//ClusterConfig is the type of a Cluster, and for Dedicated Clusters its size in CKUs
+type ClusterConfig struct {
+	Kind string `json:"kind"`
+	CKU  int    `json:"cku,omitempty"`
+}
+
+//ClusterStatus is the observed state of a Cluster
+type ClusterStatus struct {
+	Phase string `json:"phase,omitempty"`
+	CKU   int    `json:"cku,omitempty"`
+}
+
+//ClusterList is a single page of Clusters
+type ClusterList = client.ListPage[Cluster]
+
+//clusterRequest is the request body for creating or updating a Cluster
+type clusterRequest struct {
+	Spec ClusterSpec `json:"spec"`
+}
+
+//IsProvisioned checks if the Cluster is ready for use
+func (c Cluster) IsProvisioned() bool {
+	return c.Status.Phase == PhaseProvisioned
+}
+
+//TelemetryFilter returns a Telemetry filter selecting the data points of this Cluster
+func (c Cluster) TelemetryFilter() filter.FieldFilter {
+	return filter.EqualTo(labels.ResourceKafka, c.ID)
+}
+
+//ClusterIDs returns the IDs of the Clusters, such as for use with `TelemetryClient.QueryKafkaMetricForClusters`
+func ClusterIDs(clusters ...Cluster) []string {
+	ids := make([]string, len(clusters))
+	for i, c := range clusters {
+		ids[i] = c.ID
+	}
+	return ids
+}
+
+//TelemetryFilter returns Telemetry filters selecting the data points of any of the Clusters.
+//The Cluster IDs are split across filters of at most filter.DefaultMaxInValues IDs each, so that each can be sent as its own query.
+func TelemetryFilter(clusters ...Cluster) []filter.CompoundFilter {
+	return filter.InChunks(labels.ResourceKafka, filter.DefaultMaxInValues, ClusterIDs(clusters...)...)
+}
+
+//clusters is the Resource of the Clusters within an Environment
+func clusters(c *CMKClient, environment string) client.Resource[Cluster] {
+	return client.NewResource[Cluster](&c.Client, APIPathClusters.unscoped(*c, 2), environmentParams(environment), c.PageSize)
+}
+
+//Clusters returns the Resource of the Clusters within an Environment, such as to iterate over them with List
+func (client *CMKClient) Clusters(environment string) client.Resource[Cluster] {
+	return clusters(client, environment)
+}
+
+//ListClustersPage returns a single page of Clusters within an Environment. An empty page token fetches the first page
+func (client *CMKClient) ListClustersPage(environment string, pageToken string) (ClusterList, error) {
+	return clusters(client, environment).ListPage(pageToken)
+}
+
+//ListClusters returns all the Clusters, across all pages, within an Environment
+func (client *CMKClient) ListClusters(environment string) ([]Cluster, error) {
+	return clusters(client, environment).ListAll()
+}
+
+//GetCluster returns a single Cluster by its ID, such as `lkc-abc123`
+func (client *CMKClient) GetCluster(environment string, id string) (Cluster, error) {
+	return clusters(client, environment).Get(id)
+}
+
+//CreateCluster creates a new Cluster within the Environment. The Cluster will still be provisioning when returned, see WaitForProvisioned
+func (client *CMKClient) CreateCluster(environment string, spec ClusterSpec) (Cluster, error) {
+	spec.Environment = &response.ObjectReference{ID: environment}
+	return clusters(client, environment).Create(clusterRequest{Spec: spec})
+}
+
+//UpdateCluster updates the display name and/or config of an existing Cluster. Only the set fields of the spec are changed
+func (client *CMKClient) UpdateCluster(environment string, id string, spec ClusterSpec) (Cluster, error) {
+	spec.Environment = &response.ObjectReference{ID: environment}
+	return clusters(client, environment).Update(id, clusterRequest{Spec: spec})
+}
+
+//RenameCluster changes the display name of an existing Cluster
+func (client *CMKClient) RenameCluster(environment string, id string, displayName string) (Cluster, error) {
+	return client.UpdateCluster(environment, id, ClusterSpec{DisplayName: displayName})
+}
+
+//ResizeCluster changes the number of CKUs of an existing Dedicated Cluster. The Cluster will be provisioning while it's resized, see WaitForProvisioned
+func (client *CMKClient) ResizeCluster(environment string, id string, cku int) (Cluster, error) {
+	return client.UpdateCluster(environment, id, ClusterSpec{Config: &ClusterConfig{Kind: KindDedicated, CKU: cku}})
+}
+
+//DeleteCluster deletes a Cluster and all its data
+func (client *CMKClient) DeleteCluster(environment string, id string) error {
+	return clusters(client, environment).Delete(id)
+}
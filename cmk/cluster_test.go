@@ -0,0 +1,144 @@
+package cmk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(handler http.HandlerFunc) (*httptest.Server, *CMKClient) {
+	srv := httptest.NewServer(handler)
+	c := New("key", "secret")
+	c.Context.BaseURL = srv.URL
+	c.PollInterval = time.Millisecond
+	c.MaxPollInterval = 4 * time.Millisecond
+	return srv, &c
+}
+
+func TestListClusters(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/cmk/v2/clusters", r.URL.Path)
+		assert.Equal("env-1", r.URL.Query().Get("environment"))
+		assert.Equal("100", r.URL.Query().Get("page_size"))
+
+		list := ClusterList{Kind: "ClusterList"}
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			list.Data = []Cluster{{ID: "lkc-1", Spec: ClusterSpec{DisplayName: "orders"}}}
+			list.Metadata.Next = "https://api.confluent.cloud/cmk/v2/clusters?environment=env-1&page_size=100&page_token=abc"
+		case "abc":
+			list.Data = []Cluster{{ID: "lkc-2", Spec: ClusterSpec{DisplayName: "payments"}}}
+		}
+		json.NewEncoder(w).Encode(list)
+	})
+	defer srv.Close()
+
+	clusters, err := c.ListClusters("env-1")
+	assert.NoError(err)
+	assert.Len(clusters, 2, "All pages should be fetched")
+	assert.Equal([]string{"lkc-1", "lkc-2"}, ClusterIDs(clusters...))
+
+	fils := TelemetryFilter(clusters...)
+	assert.Len(fils, 1)
+	assert.True(filter.Matches(fils[0], filter.Values{"resource.kafka.id": "lkc-2"}), "Cluster IDs should filter telemetry")
+	many := make([]Cluster, filter.DefaultMaxInValues+1)
+	for i := range many {
+		many[i].ID = fmt.Sprintf("lkc-%d", i)
+	}
+	assert.Len(TelemetryFilter(many...), 2, "Long lists of Clusters should be split across filters")
+	assert.False(filter.Matches(clusters[0].TelemetryFilter(), filter.Values{"resource.kafka.id": "lkc-2"}))
+}
+
+func TestClusterCRUD(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal("env-1", r.URL.Query().Get("environment"))
+		switch r.Method + " " + r.URL.Path {
+		case "POST /cmk/v2/clusters":
+			assert.JSONEq(`{"spec":{"display_name":"orders","availability":"SINGLE_ZONE","cloud":"AWS","region":"us-west-2","config":{"kind":"Basic"},"environment":{"id":"env-1"}}}`, string(body))
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"lkc-1","kind":"Cluster","spec":{"display_name":"orders","config":{"kind":"Basic"}},"status":{"phase":"PROVISIONING"}}`))
+		case "GET /cmk/v2/clusters/lkc-1":
+			w.Write([]byte(`{"id":"lkc-1","kind":"Cluster","spec":{"display_name":"orders","kafka_bootstrap_endpoint":"SASL_SSL://pkc-1.us-west-2.aws.confluent.cloud:9092"},"status":{"phase":"PROVISIONED"}}`))
+		case "PATCH /cmk/v2/clusters/lkc-1":
+			assert.JSONEq(`{"spec":{"config":{"kind":"Dedicated","cku":3},"environment":{"id":"env-1"}}}`, string(body))
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"lkc-1","kind":"Cluster","spec":{"config":{"kind":"Dedicated","cku":3}},"status":{"phase":"PROVISIONING","cku":2}}`))
+		case "DELETE /cmk/v2/clusters/lkc-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	cluster, err := c.CreateCluster("env-1", ClusterSpec{
+		DisplayName:  "orders",
+		Availability: AvailabilitySingleZone,
+		Cloud:        "AWS",
+		Region:       "us-west-2",
+		Config:       &ClusterConfig{Kind: KindBasic},
+	})
+	assert.NoError(err)
+	assert.Equal("lkc-1", cluster.ID)
+	assert.False(cluster.IsProvisioned())
+
+	cluster, err = c.GetCluster("env-1", "lkc-1")
+	assert.NoError(err)
+	assert.True(cluster.IsProvisioned())
+	assert.Equal("SASL_SSL://pkc-1.us-west-2.aws.confluent.cloud:9092", cluster.Spec.KafkaBootstrapEndpoint)
+
+	cluster, err = c.ResizeCluster("env-1", "lkc-1", 3)
+	assert.NoError(err)
+	assert.Equal(3, cluster.Spec.Config.CKU)
+	assert.Equal(2, cluster.Status.CKU, "The status should reflect the current size while resizing")
+
+	assert.NoError(c.DeleteCluster("env-1", "lkc-1"))
+}
+
+func TestWaitForProvisioned(t *testing.T) {
+	assert := assert.New(t)
+
+	polls := 0
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		switch {
+		case polls == 2:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case polls < 4:
+			w.Write([]byte(`{"id":"lkc-1","status":{"phase":"PROVISIONING"}}`))
+		case r.URL.Path == "/cmk/v2/clusters/lkc-2":
+			w.Write([]byte(`{"id":"lkc-2","status":{"phase":"FAILED"}}`))
+		default:
+			w.Write([]byte(`{"id":"lkc-1","status":{"phase":"PROVISIONED"}}`))
+		}
+	})
+	defer srv.Close()
+
+	cluster, err := c.WaitForProvisioned(context.Background(), "env-1", "lkc-1")
+	assert.NoError(err, "Rate limited polls should be retried")
+	assert.True(cluster.IsProvisioned())
+	assert.Equal(4, polls)
+
+	_, err = c.WaitForProvisioned(context.Background(), "env-1", "lkc-2")
+	assert.IsType(ProvisioningFailedError{}, err)
+
+	polls = 0
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	c.PollInterval = time.Hour
+	_, err = c.WaitForProvisioned(ctx, "env-1", "lkc-1")
+	assert.Equal(context.DeadlineExceeded, err)
+}
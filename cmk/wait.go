@@ -0,0 +1,65 @@
+package cmk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+)
+
+//ProvisioningFailedError is returned by WaitForProvisioned when the Cluster enters the FAILED phase
+type ProvisioningFailedError struct {
+	Cluster Cluster
+}
+
+func (err ProvisioningFailedError) Error() string {
+	return fmt.Sprintf("Cluster %s failed to provision", err.Cluster.ID)
+}
+
+//WaitForProvisioned polls a Cluster until it's provisioned, it fails to provision, or the context is done.
+//The wait between polls starts at PollInterval and doubles up to MaxPollInterval. Rate limited polls are retried.
+func (client *CMKClient) WaitForProvisioned(ctx context.Context, environment string, id string) (Cluster, error) {
+	wait := client.PollInterval
+	if wait <= 0 {
+		wait = DefaultPollInterval
+	}
+	maxWait := client.MaxPollInterval
+	if maxWait < wait {
+		maxWait = wait
+	}
+
+	for {
+		cluster, err := client.GetCluster(environment, id)
+		if err != nil {
+			if !isRateLimited(err) {
+				return cluster, err
+			}
+		} else {
+			switch cluster.Status.Phase {
+			case PhaseProvisioned:
+				return cluster, nil
+			case PhaseFailed:
+				return cluster, ProvisioningFailedError{Cluster: cluster}
+			}
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return cluster, ctx.Err()
+		case <-timer.C:
+		}
+
+		if wait *= 2; wait > maxWait {
+			wait = maxWait
+		}
+	}
+}
+
+func isRateLimited(err error) bool {
+	rateLimited := client.RateLimitedError{}
+	return errors.As(err, &rateLimited)
+}
@@ -0,0 +1,25 @@
+package connect
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+)
+
+const (
+	APIPathConnectors ConnectAPIPath = "connect/v%d/environments/%s/clusters/%s/connectors"
+)
+
+//ConnectAPIPath is a path of the Connect API, versioned and scoped to an Environment and Kafka Cluster within the path
+type ConnectAPIPath client.APIPath
+
+//Format builds the full URL of the path for the client's Kafka Cluster, with any names and sub resources appended as sub paths
+func (p ConnectAPIPath) Format(client ConnectClient, apiVersion int8, ids ...string) string {
+	parts := []string{client.Context.ResolveBaseURL(), fmt.Sprintf(string(p), apiVersion, url.PathEscape(client.Environment), url.PathEscape(client.KafkaCluster))}
+	for _, id := range ids {
+		parts = append(parts, url.PathEscape(id))
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,29 @@
+package connect
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/authenticater"
+)
+
+const (
+	//DefaultBaseURL is the default Domain and Protocol for the Confluent Cloud APIs
+	DefaultBaseURL string = client.DefaultCloudBaseURL
+)
+
+//ConnectClient is the SDK Client for managing the fully managed Connectors of a single Kafka Cluster
+type ConnectClient struct {
+	client.Client
+	Environment  string
+	KafkaCluster string
+}
+
+//New Used to create a new ConnectClient from a Cloud API Key and Secret, for the Connectors of the given Environment and Kafka Cluster
+func New(apiKey string, apiSecret string, environment string, kafkaCluster string) ConnectClient {
+	c := ConnectClient{
+		Environment:  environment,
+		KafkaCluster: kafkaCluster,
+		Client:       client.New(authenticater.NewAPIKeyAuth(apiKey, apiSecret), DefaultBaseURL, client.ErrorResponseHandler),
+	}
+	c.Context.Endpoint = client.Endpoint{Service: client.ServiceCloud}
+	return c
+}
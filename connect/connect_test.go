@@ -0,0 +1,158 @@
+package connect
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/stretchr/testify/assert"
+)
+
+const connectorsPath = "/connect/v1/environments/env-1/clusters/lkc-1/connectors"
+
+const expanded = `{
+	"s3-sink": {
+		"id": {"id": "lcc-2", "id_type": "ID"},
+		"info": {"name": "s3-sink", "config": {"topics": "orders"}, "tasks": [{"connector": "s3-sink", "task": 0}], "type": "sink"},
+		"status": {"name": "s3-sink", "connector": {"state": "RUNNING"}, "tasks": [{"id": 0, "state": "FAILED", "msg": "Access Denied"}], "type": "sink"}
+	},
+	"datagen": {
+		"id": {"id": "lcc-1", "id_type": "ID"},
+		"info": {"name": "datagen", "config": {}, "type": "source"},
+		"status": {"name": "datagen", "connector": {"state": "RUNNING"}, "tasks": [{"id": 0, "state": "RUNNING"}], "type": "source"}
+	}
+}`
+
+func newTestClient(handler http.HandlerFunc) (*httptest.Server, *ConnectClient) {
+	srv := httptest.NewServer(handler)
+	c := New("key", "secret", "env-1", "lkc-1")
+	c.Context.BaseURL = srv.URL
+	return srv, &c
+}
+
+func TestListConnectors(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(connectorsPath, r.URL.Path)
+		if r.URL.Query().Get("expand") == "id,info,status" {
+			w.Write([]byte(expanded))
+		} else {
+			w.Write([]byte(`["s3-sink","datagen"]`))
+		}
+	})
+	defer srv.Close()
+
+	names, err := c.ListConnectorNames()
+	assert.NoError(err)
+	assert.Len(names, 2)
+
+	connectors, err := c.ListConnectors()
+	assert.NoError(err)
+	assert.Equal("datagen", connectors[0].Name, "Connectors should be sorted by name")
+	assert.Equal([]string{"lcc-1", "lcc-2"}, ConnectorIDs(connectors...))
+	assert.True(connectors[0].Status.IsHealthy())
+	assert.False(connectors[1].Status.IsHealthy())
+	assert.Equal("Access Denied", connectors[1].Status.FailedTasks()[0].Msg)
+	assert.True(filter.Matches(connectors[1].TelemetryFilter(), filter.Values{"resource.connector.id": "lcc-2"}))
+}
+
+func TestGetConnectorByID(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(connectorByIDHandler(t))
+	defer srv.Close()
+
+	connector, err := c.GetConnectorByID("lcc-2")
+	assert.NoError(err)
+	assert.Equal("s3-sink", connector.Name)
+	assert.Equal("lcc-2", connector.ID.ID)
+	assert.Equal("orders", connector.Info.Config["topics"])
+	assert.Equal("Access Denied", connector.Status.FailedTasks()[0].Msg)
+
+	_, err = c.GetConnectorByID("lcc-3")
+	assert.Equal(ErrConnectorNotFound, err)
+}
+
+//connectorByIDHandler serves the IDs of the Connectors, and the info and status of each from its own endpoint, failing the test on any request for expanded info or status
+func connectorByIDHandler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case connectorsPath:
+			if r.URL.Query().Get("expand") != "id" {
+				t.Errorf("Only the IDs of the Connectors should be listed, got expand=%s", r.URL.Query().Get("expand"))
+			}
+			w.Write([]byte(`{"s3-sink":{"id":{"id":"lcc-2","id_type":"ID"}},"datagen":{"id":{"id":"lcc-1","id_type":"ID"}}}`))
+		case connectorsPath + "/s3-sink":
+			w.Write([]byte(`{"name": "s3-sink", "config": {"topics": "orders"}, "tasks": [{"connector": "s3-sink", "task": 0}], "type": "sink"}`))
+		case connectorsPath + "/s3-sink/status":
+			w.Write([]byte(`{"name": "s3-sink", "connector": {"state": "RUNNING"}, "tasks": [{"id": 0, "state": "FAILED", "msg": "Access Denied"}], "type": "sink"}`))
+		default:
+			t.Errorf("Unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestConnectorLifecycle(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := []string{}
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		call := r.Method + " " + r.URL.Path[len(connectorsPath):]
+		calls = append(calls, call)
+		switch call {
+		case "POST ":
+			assert.JSONEq(`{"name":"datagen","config":{"kafka.topic":"orders"}}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"name":"datagen","config":{"kafka.topic":"orders"},"type":"source"}`))
+		case "GET /datagen":
+			w.Write([]byte(`{"name":"datagen","config":{"kafka.topic":"orders"},"tasks":[{"connector":"datagen","task":0}],"type":"source"}`))
+		case "GET /datagen/config":
+			w.Write([]byte(`{"kafka.topic":"orders","kafka.api.secret":"****************"}`))
+		case "PUT /datagen/config":
+			assert.JSONEq(`{"kafka.topic":"payments"}`, string(body))
+			w.Write([]byte(`{"name":"datagen","config":{"kafka.topic":"payments"}}`))
+		case "GET /datagen/status":
+			w.Write([]byte(`{"name":"datagen","connector":{"state":"PAUSED"},"tasks":[{"id":0,"state":"PAUSED"}]}`))
+		case "PUT /datagen/pause", "PUT /datagen/resume":
+			w.WriteHeader(http.StatusAccepted)
+		case "POST /datagen/restart":
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE /datagen":
+			w.Write([]byte(`{"error":null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	info, err := c.CreateConnector("datagen", map[string]string{"kafka.topic": "orders"})
+	assert.NoError(err)
+	assert.Equal("source", info.Type)
+
+	info, err = c.GetConnector("datagen")
+	assert.NoError(err)
+	assert.Len(info.Tasks, 1)
+
+	config, err := c.GetConnectorConfig("datagen")
+	assert.NoError(err)
+	assert.Equal("orders", config["kafka.topic"])
+
+	info, err = c.UpdateConnectorConfig("datagen", map[string]string{"kafka.topic": "payments"})
+	assert.NoError(err)
+	assert.Equal("payments", info.Config["kafka.topic"])
+
+	assert.NoError(c.PauseConnector("datagen"))
+	status, err := c.GetConnectorStatus("datagen")
+	assert.NoError(err)
+	assert.Equal(StatePaused, status.Connector.State)
+	assert.NoError(c.ResumeConnector("datagen"))
+	assert.NoError(c.RestartConnector("datagen"))
+	assert.NoError(c.DeleteConnector("datagen"))
+
+	assert.Len(calls, 9)
+}
@@ -0,0 +1,20 @@
+//Package connectalert resolves Telemetry Alerts, such as ones on `ConnectorDeadLetterQueueRecords`, to the Connectors they fired for
+package connectalert
+
+import (
+	"fmt"
+
+	"github.com/nerdynick/ccloud-go-sdk/connect"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/alerting"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+)
+
+//ConnectorForAlert returns the Connector, with its Task status, that an Alert fired for, such as one on `ConnectorDeadLetterQueueRecords`.
+//The Alert's series must include the `resource.connector.id` label
+func ConnectorForAlert(client *connect.ConnectClient, alert alerting.Alert) (connect.Connector, error) {
+	id, ok := alert.Series[labels.Key(labels.ResourceConnector)]
+	if !ok || id == "" {
+		return connect.Connector{}, fmt.Errorf("Alert `%s` isn't for a Connector, its series has no `%s` label", alert.Rule, labels.Key(labels.ResourceConnector))
+	}
+	return client.GetConnectorByID(id)
+}
@@ -0,0 +1,39 @@
+package connectalert
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nerdynick/ccloud-go-sdk/connect"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/alerting"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectorForAlert(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/connect/v1/environments/env-1/clusters/lkc-1/connectors":
+			w.Write([]byte(`{"s3-sink":{"id":{"id":"lcc-2","id_type":"ID"}}}`))
+		case "/connect/v1/environments/env-1/clusters/lkc-1/connectors/s3-sink":
+			w.Write([]byte(`{"name":"s3-sink","config":{},"type":"sink"}`))
+		case "/connect/v1/environments/env-1/clusters/lkc-1/connectors/s3-sink/status":
+			w.Write([]byte(`{"name":"s3-sink","connector":{"state":"RUNNING"},"tasks":[{"id":0,"state":"FAILED","msg":"Access Denied"}],"type":"sink"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	c := connect.New("key", "secret", "env-1", "lkc-1")
+	c.Context.BaseURL = srv.URL
+
+	connector, err := ConnectorForAlert(&c, alerting.Alert{Rule: "dlq", Series: map[string]string{"resource.connector.id": "lcc-2"}})
+	assert.NoError(err, "Alerts should resolve to their Connector")
+	assert.Equal("s3-sink", connector.Name)
+	assert.Equal("Access Denied", connector.Status.FailedTasks()[0].Msg)
+
+	_, err = ConnectorForAlert(&c, alerting.Alert{Rule: "lag", Series: map[string]string{"resource.kafka.id": "lkc-1"}})
+	assert.Error(err, "Alerts without a Connector ID should error")
+}
@@ -0,0 +1,225 @@
+package connect
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+)
+
+const (
+	//StateProvisioning is the state of a Connector that is still being created
+	StateProvisioning string = "PROVISIONING"
+	//StateRunning is the state of a healthy Connector or Task
+	StateRunning string = "RUNNING"
+	//StateDegraded is the state of a Connector with some failed Tasks
+	StateDegraded string = "DEGRADED"
+	//StatePaused is the state of a paused Connector or Task
+	StatePaused string = "PAUSED"
+	//StateFailed is the state of a failed Connector or Task
+	StateFailed string = "FAILED"
+	//StateUnassigned is the state of a Task yet to be assigned to a worker
+	StateUnassigned string = "UNASSIGNED"
+)
+
+//ErrConnectorNotFound is returned when looking up a Connector by an ID that doesn't exist within the Kafka Cluster
+var ErrConnectorNotFound = errors.New("No Connector found with the given ID")
+
+//ConnectorID is the ID of a Connector, such as `lcc-abc123`, matching the `resource.connector.id` Telemetry label
+type ConnectorID struct {
+	ID     string `json:"id"`
+	IDType string `json:"id_type"`
+}
+
+//ConnectorInfo is the config and tasks of a Connector
+type ConnectorInfo struct {
+	Name   string            `json:"name"`
+	Config map[string]string `json:"config"`
+	Tasks  []TaskID          `json:"tasks"`
+	Type   string            `json:"type"`
+}
+
+//TaskID identifies a single Task of a Connector
+type TaskID struct {
+	Connector string `json:"connector"`
+	Task      int    `json:"task"`
+}
+
+//ConnectorStatus is the state of a Connector and each of its Tasks
+type ConnectorStatus struct {
+	Name      string         `json:"name"`
+	Connector ConnectorState `json:"connector"`
+	Tasks     []TaskStatus   `json:"tasks"`
+	Type      string         `json:"type"`
+}
+
+//ConnectorState is the state of a Connector itself
+type ConnectorState struct {
+	State    string `json:"state"`
+	WorkerID string `json:"worker_id"`
+	Trace    string `json:"trace,omitempty"`
+}
+
+//TaskStatus is the state of a single Task of a Connector
+type TaskStatus struct {
+	ID       int    `json:"id"`
+	State    string `json:"state"`
+	WorkerID string `json:"worker_id"`
+	Msg      string `json:"msg,omitempty"`
+	Trace    string `json:"trace,omitempty"`
+}
+
+//Connector is a Connector expanded with its ID, info and status
+type Connector struct {
+	Name   string          `json:"-"`
+	ID     ConnectorID     `json:"id"`
+	Info   ConnectorInfo   `json:"info"`
+	Status ConnectorStatus `json:"status"`
+}
+
+//createConnectorRequest is the request body for creating a Connector
+type createConnectorRequest struct {
+	Name   string            `json:"name"`
+	Config map[string]string `json:"config"`
+}
+
+//FailedTasks returns the Tasks in the FAILED state
+func (s ConnectorStatus) FailedTasks() []TaskStatus {
+	failed := []TaskStatus{}
+	for _, t := range s.Tasks {
+		if t.State == StateFailed {
+			failed = append(failed, t)
+		}
+	}
+	return failed
+}
+
+//IsHealthy checks if the Connector and all its Tasks are running
+func (s ConnectorStatus) IsHealthy() bool {
+	return s.Connector.State == StateRunning && len(s.FailedTasks()) == 0
+}
+
+//TelemetryFilter returns a Telemetry filter selecting the data points of this Connector
+func (c Connector) TelemetryFilter() filter.FieldFilter {
+	return filter.EqualTo(labels.ResourceConnector, c.ID.ID)
+}
+
+//ConnectorIDs returns the IDs of the Connectors, such as for use with `TelemetryClient.QueryConnectorMetricForConnectors`
+func ConnectorIDs(connectors ...Connector) []string {
+	ids := make([]string, len(connectors))
+	for i, c := range connectors {
+		ids[i] = c.ID.ID
+	}
+	return ids
+}
+
+//ListConnectorNames returns the names of all the Connectors within the Kafka Cluster
+func (client *ConnectClient) ListConnectorNames() ([]string, error) {
+	names := []string{}
+	err := client.Get(&names, APIPathConnectors.Format(*client, 1))
+	return names, err
+}
+
+//ListConnectors returns all the Connectors within the Kafka Cluster, expanded with their ID, info and status, sorted by name
+func (client *ConnectClient) ListConnectors() ([]Connector, error) {
+	expanded := map[string]Connector{}
+	err := client.Get(&expanded, APIPathConnectors.Format(*client, 1)+"?expand=id,info,status")
+	if err != nil {
+		return nil, err
+	}
+
+	connectors := make([]Connector, 0, len(expanded))
+	for name, c := range expanded {
+		c.Name = name
+		connectors = append(connectors, c)
+	}
+	sort.Slice(connectors, func(i, j int) bool {
+		return connectors[i].Name < connectors[j].Name
+	})
+	return connectors, nil
+}
+
+//GetConnectorByID returns a Connector by its ID, such as the `resource.connector.id` label of a Telemetry data point.
+//As the API addresses Connectors by name, only the IDs of the Connectors are listed to find its name. Its info and status are then fetched from the Connector's own endpoints
+func (client *ConnectClient) GetConnectorByID(id string) (Connector, error) {
+	ids := map[string]Connector{}
+	err := client.Get(&ids, APIPathConnectors.Format(*client, 1)+"?expand=id")
+	if err != nil {
+		return Connector{}, err
+	}
+
+	for name, c := range ids {
+		if c.ID.ID != id {
+			continue
+		}
+
+		c.Name = name
+		if c.Info, err = client.GetConnector(name); err != nil {
+			return Connector{}, err
+		}
+		if c.Status, err = client.GetConnectorStatus(name); err != nil {
+			return Connector{}, err
+		}
+		return c, nil
+	}
+	return Connector{}, ErrConnectorNotFound
+}
+
+//GetConnector returns the info of a Connector by its name
+func (client *ConnectClient) GetConnector(name string) (ConnectorInfo, error) {
+	info := ConnectorInfo{}
+	err := client.Get(&info, APIPathConnectors.Format(*client, 1, name))
+	return info, err
+}
+
+//CreateConnector creates a new Connector
+func (client *ConnectClient) CreateConnector(name string, config map[string]string) (ConnectorInfo, error) {
+	info := ConnectorInfo{}
+	err := client.Post(&info, APIPathConnectors.Format(*client, 1), createConnectorRequest{Name: name, Config: config})
+	return info, err
+}
+
+//GetConnectorConfig returns the config of a Connector. Sensitive values, such as credentials, are masked by the API
+func (client *ConnectClient) GetConnectorConfig(name string) (map[string]string, error) {
+	config := map[string]string{}
+	err := client.Get(&config, APIPathConnectors.Format(*client, 1, name, "config"))
+	return config, err
+}
+
+//UpdateConnectorConfig replaces the config of a Connector, creating it if it doesn't exist
+func (client *ConnectClient) UpdateConnectorConfig(name string, config map[string]string) (ConnectorInfo, error) {
+	info := ConnectorInfo{}
+	err := client.Put(&info, APIPathConnectors.Format(*client, 1, name, "config"), config)
+	return info, err
+}
+
+//GetConnectorStatus returns the state of a Connector and each of its Tasks
+func (client *ConnectClient) GetConnectorStatus(name string) (ConnectorStatus, error) {
+	status := ConnectorStatus{}
+	err := client.Get(&status, APIPathConnectors.Format(*client, 1, name, "status"))
+	return status, err
+}
+
+//PauseConnector pauses a Connector and all its Tasks
+func (client *ConnectClient) PauseConnector(name string) error {
+	_, err := client.SendRequest("PUT", APIPathConnectors.Format(*client, 1, name, "pause"), nil)
+	return err
+}
+
+//ResumeConnector resumes a paused Connector and all its Tasks
+func (client *ConnectClient) ResumeConnector(name string) error {
+	_, err := client.SendRequest("PUT", APIPathConnectors.Format(*client, 1, name, "resume"), nil)
+	return err
+}
+
+//RestartConnector restarts a Connector, such as after it has FAILED
+func (client *ConnectClient) RestartConnector(name string) error {
+	_, err := client.SendRequest("POST", APIPathConnectors.Format(*client, 1, name, "restart"), nil)
+	return err
+}
+
+//DeleteConnector deletes a Connector
+func (client *ConnectClient) DeleteConnector(name string) error {
+	return client.Delete(nil, APIPathConnectors.Format(*client, 1, name))
+}
@@ -1,10 +1,18 @@
 module github.com/nerdynick/ccloud-go-sdk
 
-go 1.14
+go 1.18
 
 require (
-	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/json-iterator/go v1.1.10
+	github.com/rickb777/date v1.15.3
+	github.com/sirupsen/logrus v1.8.1
+	github.com/stretchr/testify v1.7.0
+	go.uber.org/zap v1.16.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/konsorten/go-windows-terminal-sequences v1.0.1 // indirect
 	github.com/kr/text v0.2.0 // indirect
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
@@ -12,12 +20,11 @@ require (
 	github.com/onsi/ginkgo v1.16.1 // indirect
 	github.com/onsi/gomega v1.11.0 // indirect
 	github.com/pkg/errors v0.9.1 // indirect
-	github.com/rickb777/date v1.15.3
-	github.com/sirupsen/logrus v1.8.1
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/rickb777/plural v1.3.0 // indirect
 	github.com/stretchr/objx v0.1.1 // indirect
-	github.com/stretchr/testify v1.7.0
+	go.uber.org/atomic v1.7.0 // indirect
 	go.uber.org/multierr v1.6.0 // indirect
-	go.uber.org/zap v1.16.0
 	golang.org/x/lint v0.0.0-20201208152925-83fdc39ff7b5 // indirect
 	golang.org/x/mod v0.4.2 // indirect
 	golang.org/x/net v0.0.0-20210423184538-5f58ad60dda6 // indirect
@@ -0,0 +1,97 @@
+package iam
+
+import (
+	"net/url"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+)
+
+const (
+	//KindAPIKey is the kind of APIKey resources
+	KindAPIKey string = "ApiKey"
+)
+
+//APIKey is a Confluent Cloud API Key, owned by a User or ServiceAccount and scoped to either a resource, such as a Kafka Cluster, or the Cloud APIs
+type APIKey struct {
+	APIVersion string                  `json:"api_version,omitempty"`
+	Kind       string                  `json:"kind,omitempty"`
+	ID         string                  `json:"id,omitempty"`
+	Metadata   response.ObjectMetadata `json:"metadata,omitempty"`
+	Spec       APIKeySpec              `json:"spec"`
+}
+
+//APIKeySpec is the desired state of an APIKey
+type APIKeySpec struct {
+	DisplayName string                    `json:"display_name,omitempty"`
+	Description string                    `json:"description,omitempty"`
+	Owner       *response.ObjectReference `json:"owner,omitempty"`
+	Resource    *response.ObjectReference `json:"resource,omitempty"`
+	//Secret is only returned when the APIKey is created, and is masked whenever printed, logged or marshaled
+	Secret client.SecurePassword `json:"secret,omitempty"`
+}
+
+//APIKeyList is a single page of APIKeys
+type APIKeyList = client.ListPage[APIKey]
+
+//APIKeyFilter scopes a listing of APIKeys to an owner and/or resource. Empty fields aren't filtered on
+type APIKeyFilter struct {
+	Owner    string
+	Resource string
+}
+
+//apiKeyRequest is the request body for creating an APIKey
+type apiKeyRequest struct {
+	Spec APIKeySpec `json:"spec"`
+}
+
+func (f APIKeyFilter) params() url.Values {
+	params := url.Values{}
+	if f.Owner != "" {
+		params.Set("spec.owner", f.Owner)
+	}
+	if f.Resource != "" {
+		params.Set("spec.resource", f.Resource)
+	}
+	return params
+}
+
+//apiKeys is the Resource of the APIKeys matching the filter
+func apiKeys(c *IAMClient, fil APIKeyFilter) client.Resource[APIKey] {
+	return client.NewResource[APIKey](&c.Client, APIPathAPIKeys.Format(*c, 2), fil.params(), c.PageSize)
+}
+
+//APIKeys returns the Resource of the APIKeys matching the filter, such as to iterate over them with List
+func (client *IAMClient) APIKeys(fil APIKeyFilter) client.Resource[APIKey] {
+	return apiKeys(client, fil)
+}
+
+//ListAPIKeysPage returns a single page of APIKeys matching the filter. An empty page token fetches the first page
+func (client *IAMClient) ListAPIKeysPage(fil APIKeyFilter, pageToken string) (APIKeyList, error) {
+	return apiKeys(client, fil).ListPage(pageToken)
+}
+
+//ListAPIKeys returns all the APIKeys, across all pages, matching the filter
+func (client *IAMClient) ListAPIKeys(fil APIKeyFilter) ([]APIKey, error) {
+	return apiKeys(client, fil).ListAll()
+}
+
+//CreateAPIKey creates a new APIKey for the owner, such as `sa-abc123`, scoped to the resource, such as `lkc-abc123` within `env-abc123`.
+//An empty resource ID creates a Cloud API Key. The returned APIKey holds the secret, which can't be retrieved again
+func (client *IAMClient) CreateAPIKey(owner string, resource string, environment string, displayName string, description string) (APIKey, error) {
+	spec := APIKeySpec{
+		DisplayName: displayName,
+		Description: description,
+		Owner:       &response.ObjectReference{ID: owner},
+	}
+	if resource != "" {
+		spec.Resource = &response.ObjectReference{ID: resource, Environment: environment}
+	}
+
+	return apiKeys(client, APIKeyFilter{}).Create(apiKeyRequest{Spec: spec})
+}
+
+//DeleteAPIKey deletes an APIKey, immediately revoking access for anything using it
+func (client *IAMClient) DeleteAPIKey(id string) error {
+	return apiKeys(client, APIKeyFilter{}).Delete(id)
+}
@@ -0,0 +1,27 @@
+package iam
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+)
+
+const (
+	APIPathServiceAccounts IAMAPIPath = "iam/v%d/service-accounts"
+	APIPathUsers           IAMAPIPath = "iam/v%d/users"
+	APIPathAPIKeys         IAMAPIPath = "iam/v%d/api-keys"
+)
+
+//IAMAPIPath is a path of the IAM API, versioned within the path such as `iam/v2/api-keys`
+type IAMAPIPath client.APIPath
+
+//Format builds the full URL of the path, with any IDs appended as sub paths
+func (p IAMAPIPath) Format(client IAMClient, apiVersion int8, ids ...string) string {
+	parts := []string{client.Context.ResolveBaseURL(), fmt.Sprintf(string(p), apiVersion)}
+	for _, id := range ids {
+		parts = append(parts, url.PathEscape(id))
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,29 @@
+package iam
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/authenticater"
+)
+
+const (
+	//DefaultBaseURL is the default Domain and Protocol for the Confluent Cloud APIs
+	DefaultBaseURL string = client.DefaultCloudBaseURL
+	//DefaultPageSize is the default number of results to request per page when listing
+	DefaultPageSize int = 100
+)
+
+//IAMClient is the SDK Client for managing Confluent Cloud Service Accounts, Users and API Keys
+type IAMClient struct {
+	client.Client
+	PageSize int
+}
+
+//New Used to create a new IAMClient from a Cloud API Key and Secret
+func New(apiKey string, apiSecret string) IAMClient {
+	c := IAMClient{
+		PageSize: DefaultPageSize,
+		Client:   client.New(authenticater.NewAPIKeyAuth(apiKey, apiSecret), DefaultBaseURL, client.ErrorResponseHandler),
+	}
+	c.Context.Endpoint = client.Endpoint{Service: client.ServiceCloud}
+	return c
+}
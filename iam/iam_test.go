@@ -0,0 +1,117 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(handler http.HandlerFunc) (*httptest.Server, *IAMClient) {
+	srv := httptest.NewServer(handler)
+	c := New("key", "secret")
+	c.Context.BaseURL = srv.URL
+	return srv, &c
+}
+
+func TestServiceAccountCRUD(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /iam/v2/service-accounts":
+			w.Write([]byte(`{"data":[{"id":"sa-1","display_name":"ci"}]}`))
+		case "POST /iam/v2/service-accounts":
+			assert.JSONEq(`{"display_name":"ci","description":"Builds"}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"sa-1","kind":"ServiceAccount","display_name":"ci","description":"Builds"}`))
+		case "PATCH /iam/v2/service-accounts/sa-1":
+			assert.JSONEq(`{"description":"Deploys"}`, string(body))
+			w.Write([]byte(`{"id":"sa-1","display_name":"ci","description":"Deploys"}`))
+		case "DELETE /iam/v2/service-accounts/sa-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	account, err := c.CreateServiceAccount("ci", "Builds")
+	assert.NoError(err)
+	assert.Equal("sa-1", account.ID)
+
+	accounts, err := c.ListServiceAccounts()
+	assert.NoError(err)
+	assert.Len(accounts, 1)
+
+	account, err = c.UpdateServiceAccount("sa-1", "Deploys")
+	assert.NoError(err)
+	assert.Equal("Deploys", account.Description)
+
+	assert.NoError(c.DeleteServiceAccount("sa-1"))
+}
+
+func TestListUsers(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/iam/v2/users/u-1" {
+			w.Write([]byte(`{"id":"u-1","email":"jane@example.com","full_name":"Jane"}`))
+			return
+		}
+		list := UserList{Data: []User{{ID: "u-1"}, {ID: "u-2"}}}
+		json.NewEncoder(w).Encode(list)
+	})
+	defer srv.Close()
+
+	users, err := c.ListUsers()
+	assert.NoError(err)
+	assert.Len(users, 2)
+
+	user, err := c.GetUser("u-1")
+	assert.NoError(err)
+	assert.Equal("jane@example.com", user.Email)
+}
+
+func TestAPIKeys(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.Path {
+		case "POST /iam/v2/api-keys":
+			assert.JSONEq(`{"spec":{"display_name":"ci","owner":{"id":"sa-1"},"resource":{"id":"lkc-1","environment":"env-1"}}}`, string(body))
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"id":"KEY1","kind":"ApiKey","spec":{"display_name":"ci","secret":"hunter2","owner":{"id":"sa-1"},"resource":{"id":"lkc-1"}}}`))
+		case "GET /iam/v2/api-keys":
+			assert.Equal("sa-1", r.URL.Query().Get("spec.owner"))
+			assert.Equal("lkc-1", r.URL.Query().Get("spec.resource"))
+			assert.Equal("100", r.URL.Query().Get("page_size"))
+			w.Write([]byte(`{"data":[{"id":"KEY1","spec":{"owner":{"id":"sa-1"}}}]}`))
+		case "DELETE /iam/v2/api-keys/KEY1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	key, err := c.CreateAPIKey("sa-1", "lkc-1", "env-1", "ci", "")
+	assert.NoError(err)
+	assert.Equal("KEY1", key.ID)
+	assert.Equal("hunter2", key.Spec.Secret.Value())
+	assert.NotContains(fmt.Sprintf("%+v", key), "hunter2", "The secret should never be printed")
+	js, _ := json.Marshal(key)
+	assert.NotContains(string(js), "hunter2", "The secret should never be marshaled")
+
+	keys, err := c.ListAPIKeys(APIKeyFilter{Owner: "sa-1", Resource: "lkc-1"})
+	assert.NoError(err)
+	assert.Len(keys, 1)
+
+	assert.NoError(c.DeleteAPIKey("KEY1"))
+}
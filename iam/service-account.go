@@ -0,0 +1,70 @@
+package iam
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+)
+
+const (
+	//KindServiceAccount is the kind of ServiceAccount resources
+	KindServiceAccount string = "ServiceAccount"
+)
+
+//ServiceAccount is a Confluent Cloud Service Account, an identity for applications and automation
+type ServiceAccount struct {
+	APIVersion  string                  `json:"api_version,omitempty"`
+	Kind        string                  `json:"kind,omitempty"`
+	ID          string                  `json:"id,omitempty"`
+	Metadata    response.ObjectMetadata `json:"metadata,omitempty"`
+	DisplayName string                  `json:"display_name,omitempty"`
+	Description string                  `json:"description,omitempty"`
+}
+
+//ServiceAccountList is a single page of ServiceAccounts
+type ServiceAccountList = client.ListPage[ServiceAccount]
+
+//serviceAccountSpec is the request body for creating or updating a ServiceAccount
+type serviceAccountSpec struct {
+	DisplayName string `json:"display_name,omitempty"`
+	Description string `json:"description"`
+}
+
+//serviceAccounts is the Resource of the ServiceAccounts within the Organization
+func serviceAccounts(c *IAMClient) client.Resource[ServiceAccount] {
+	return client.NewResource[ServiceAccount](&c.Client, APIPathServiceAccounts.Format(*c, 2), nil, c.PageSize)
+}
+
+//ServiceAccounts returns the Resource of the ServiceAccounts within the Organization, such as to iterate over them with List
+func (client *IAMClient) ServiceAccounts() client.Resource[ServiceAccount] {
+	return serviceAccounts(client)
+}
+
+//ListServiceAccountsPage returns a single page of ServiceAccounts. An empty page token fetches the first page
+func (client *IAMClient) ListServiceAccountsPage(pageToken string) (ServiceAccountList, error) {
+	return serviceAccounts(client).ListPage(pageToken)
+}
+
+//ListServiceAccounts returns all the ServiceAccounts, across all pages, within the Organization
+func (client *IAMClient) ListServiceAccounts() ([]ServiceAccount, error) {
+	return serviceAccounts(client).ListAll()
+}
+
+//GetServiceAccount returns a single ServiceAccount by its ID, such as `sa-abc123`
+func (client *IAMClient) GetServiceAccount(id string) (ServiceAccount, error) {
+	return serviceAccounts(client).Get(id)
+}
+
+//CreateServiceAccount creates a new ServiceAccount. The display name must be unique within the Organization
+func (client *IAMClient) CreateServiceAccount(displayName string, description string) (ServiceAccount, error) {
+	return serviceAccounts(client).Create(serviceAccountSpec{DisplayName: displayName, Description: description})
+}
+
+//UpdateServiceAccount changes the description of an existing ServiceAccount
+func (client *IAMClient) UpdateServiceAccount(id string, description string) (ServiceAccount, error) {
+	return serviceAccounts(client).Update(id, serviceAccountSpec{Description: description})
+}
+
+//DeleteServiceAccount deletes a ServiceAccount, along with any API Keys it owns
+func (client *IAMClient) DeleteServiceAccount(id string) error {
+	return serviceAccounts(client).Delete(id)
+}
@@ -0,0 +1,50 @@
+package iam
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+)
+
+const (
+	//KindUser is the kind of User resources
+	KindUser string = "User"
+)
+
+//User is a Confluent Cloud User, a person with access to the Organization
+type User struct {
+	APIVersion string                  `json:"api_version,omitempty"`
+	Kind       string                  `json:"kind,omitempty"`
+	ID         string                  `json:"id,omitempty"`
+	Metadata   response.ObjectMetadata `json:"metadata,omitempty"`
+	Email      string                  `json:"email,omitempty"`
+	FullName   string                  `json:"full_name,omitempty"`
+	AuthType   string                  `json:"auth_type,omitempty"`
+}
+
+//UserList is a single page of Users
+type UserList = client.ListPage[User]
+
+//users is the Resource of the Users within the Organization
+func users(c *IAMClient) client.Resource[User] {
+	return client.NewResource[User](&c.Client, APIPathUsers.Format(*c, 2), nil, c.PageSize)
+}
+
+//Users returns the Resource of the Users within the Organization, such as to iterate over them with List
+func (client *IAMClient) Users() client.Resource[User] {
+	return users(client)
+}
+
+//ListUsersPage returns a single page of Users. An empty page token fetches the first page
+func (client *IAMClient) ListUsersPage(pageToken string) (UserList, error) {
+	return users(client).ListPage(pageToken)
+}
+
+//ListUsers returns all the Users, across all pages, within the Organization
+func (client *IAMClient) ListUsers() ([]User, error) {
+	return users(client).ListAll()
+}
+
+//GetUser returns a single User by its ID, such as `u-abc123`
+func (client *IAMClient) GetUser(id string) (User, error) {
+	return users(client).Get(id)
+}
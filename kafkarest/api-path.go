@@ -0,0 +1,27 @@
+package kafkarest
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+)
+
+const (
+	APIPathTopics         KafkaRESTAPIPath = "kafka/v%d/clusters/%s/topics"
+	APIPathBrokers        KafkaRESTAPIPath = "kafka/v%d/clusters/%s/brokers"
+	APIPathConsumerGroups KafkaRESTAPIPath = "kafka/v%d/clusters/%s/consumer-groups"
+)
+
+//KafkaRESTAPIPath is a path of the Kafka REST API, versioned and scoped to a Cluster within the path such as `kafka/v3/clusters/lkc-abc123/topics`
+type KafkaRESTAPIPath client.APIPath
+
+//Format builds the full URL of the path for the client's Cluster, with any IDs and sub resources appended as sub paths
+func (p KafkaRESTAPIPath) Format(client KafkaRESTClient, apiVersion int8, ids ...string) string {
+	parts := []string{client.Context.ResolveBaseURL(), fmt.Sprintf(string(p), apiVersion, url.PathEscape(client.ClusterID))}
+	for _, id := range ids {
+		parts = append(parts, url.PathEscape(id))
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,30 @@
+package kafkarest
+
+import (
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/authenticater"
+)
+
+//KafkaRESTClient is the SDK Client for the Kafka REST v3 API of a single Kafka Cluster
+type KafkaRESTClient struct {
+	client.Client
+	ClusterID string
+}
+
+//New Used to create a new KafkaRESTClient from the Cluster's REST endpoint, such as the `http_endpoint` of a `cmk.Cluster`, its ID and a Cluster API Key and Secret
+func New(restEndpoint string, clusterID string, apiKey string, apiSecret string) KafkaRESTClient {
+	c := KafkaRESTClient{
+		ClusterID: clusterID,
+		Client:    client.New(authenticater.NewAPIKeyAuth(apiKey, apiSecret), strings.TrimSuffix(restEndpoint, "/"), client.ErrorResponseHandler),
+	}
+	c.Context.Endpoint = client.Endpoint{Service: client.ServiceKafkaREST, ResourceID: clusterID}
+	return c
+}
+
+//listAll lists a collection of the Kafka REST API across all pages, such as the Topics of the Cluster.
+//Each next page is requested from the collection's resolved URL with only the page token of the `next` link, so an EndpointResolver override isn't bypassed by the link's host
+func listAll[T any](c *client.Client, url string) ([]T, error) {
+	return client.NewResource[T](c, url, nil, 0).ListAll()
+}
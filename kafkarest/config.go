@@ -0,0 +1,80 @@
+package kafkarest
+
+import (
+	"strconv"
+
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+)
+
+const (
+	//OpSet sets the value of a config when altering configs
+	OpSet string = "SET"
+	//OpDelete resets a config back to its default when altering configs
+	OpDelete string = "DELETE"
+)
+
+//Config is a single config of a Topic or Broker
+type Config struct {
+	Kind        string                  `json:"kind,omitempty"`
+	Metadata    response.ObjectMetadata `json:"metadata,omitempty"`
+	ClusterID   string                  `json:"cluster_id"`
+	TopicName   string                  `json:"topic_name,omitempty"`
+	BrokerID    *int                    `json:"broker_id,omitempty"`
+	Name        string                  `json:"name"`
+	Value       *string                 `json:"value"`
+	IsDefault   bool                    `json:"is_default"`
+	IsReadOnly  bool                    `json:"is_read_only"`
+	IsSensitive bool                    `json:"is_sensitive"`
+	Source      string                  `json:"source"`
+	Synonyms    []ConfigSynonym         `json:"synonyms"`
+}
+
+//ConfigSynonym is another source a Config's value could come from, in order of precedence
+type ConfigSynonym struct {
+	Name   string  `json:"name"`
+	Value  *string `json:"value"`
+	Source string  `json:"source"`
+}
+
+//ConfigList is a single page of Configs
+type ConfigList struct {
+	Kind     string                `json:"kind,omitempty"`
+	Metadata response.ListMetadata `json:"metadata"`
+	Data     []Config              `json:"data"`
+}
+
+//ConfigValue is a config to set when creating a Topic, or to set or delete when altering configs
+type ConfigValue struct {
+	Name      string `json:"name"`
+	Value     string `json:"value,omitempty"`
+	Operation string `json:"operation,omitempty"`
+}
+
+//alterConfigsRequest is the request body for altering a batch of configs
+type alterConfigsRequest struct {
+	Data []ConfigValue `json:"data"`
+}
+
+//GetTopicConfigs returns all the configs of a Topic
+func (client *KafkaRESTClient) GetTopicConfigs(topicName string) ([]Config, error) {
+	return client.listConfigs(APIPathTopics.Format(*client, 3, topicName, "configs"))
+}
+
+//AlterTopicConfigs sets or deletes a batch of configs of a Topic
+func (client *KafkaRESTClient) AlterTopicConfigs(topicName string, configs ...ConfigValue) error {
+	return client.Post(nil, APIPathTopics.Format(*client, 3, topicName, "configs")+":alter", alterConfigsRequest{Data: configs})
+}
+
+//GetBrokerConfigs returns all the configs of a Broker
+func (client *KafkaRESTClient) GetBrokerConfigs(brokerID int) ([]Config, error) {
+	return client.listConfigs(APIPathBrokers.Format(*client, 3, strconv.Itoa(brokerID), "configs"))
+}
+
+//AlterBrokerConfigs sets or deletes a batch of configs of a Broker
+func (client *KafkaRESTClient) AlterBrokerConfigs(brokerID int, configs ...ConfigValue) error {
+	return client.Post(nil, APIPathBrokers.Format(*client, 3, strconv.Itoa(brokerID), "configs")+":alter", alterConfigsRequest{Data: configs})
+}
+
+func (client *KafkaRESTClient) listConfigs(url string) ([]Config, error) {
+	return listAll[Config](&client.Client, url)
+}
@@ -0,0 +1,59 @@
+package kafkarest
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+)
+
+//ConsumerGroup is a Kafka Consumer Group
+type ConsumerGroup struct {
+	Kind              string                  `json:"kind,omitempty"`
+	Metadata          response.ObjectMetadata `json:"metadata,omitempty"`
+	ClusterID         string                  `json:"cluster_id"`
+	ConsumerGroupID   string                  `json:"consumer_group_id"`
+	IsSimple          bool                    `json:"is_simple"`
+	PartitionAssignor string                  `json:"partition_assignor"`
+	State             string                  `json:"state"`
+	Coordinator       Relationship            `json:"coordinator"`
+	Consumers         Relationship            `json:"consumers"`
+	LagSummary        Relationship            `json:"lag_summary"`
+}
+
+//ConsumerGroupList is a single page of ConsumerGroups
+type ConsumerGroupList struct {
+	Kind     string                `json:"kind,omitempty"`
+	Metadata response.ListMetadata `json:"metadata"`
+	Data     []ConsumerGroup       `json:"data"`
+}
+
+//ConsumerLag is the lag of a Consumer Group for a single Partition
+type ConsumerLag struct {
+	Kind            string                  `json:"kind,omitempty"`
+	Metadata        response.ObjectMetadata `json:"metadata,omitempty"`
+	ClusterID       string                  `json:"cluster_id"`
+	ConsumerGroupID string                  `json:"consumer_group_id"`
+	TopicName       string                  `json:"topic_name"`
+	PartitionID     int                     `json:"partition_id"`
+	ConsumerID      string                  `json:"consumer_id"`
+	InstanceID      string                  `json:"instance_id,omitempty"`
+	ClientID        string                  `json:"client_id"`
+	CurrentOffset   int64                   `json:"current_offset"`
+	LogEndOffset    int64                   `json:"log_end_offset"`
+	Lag             int64                   `json:"lag"`
+}
+
+//ConsumerLagList is a single page of ConsumerLags
+type ConsumerLagList struct {
+	Kind     string                `json:"kind,omitempty"`
+	Metadata response.ListMetadata `json:"metadata"`
+	Data     []ConsumerLag         `json:"data"`
+}
+
+//ListConsumerGroups returns all the ConsumerGroups, across all pages, within the Cluster
+func (client *KafkaRESTClient) ListConsumerGroups() ([]ConsumerGroup, error) {
+	return listAll[ConsumerGroup](&client.Client, APIPathConsumerGroups.Format(*client, 3))
+}
+
+//GetConsumerLags returns the lag of a ConsumerGroup for each Partition it consumes
+func (client *KafkaRESTClient) GetConsumerLags(consumerGroupID string) ([]ConsumerLag, error) {
+	return listAll[ConsumerLag](&client.Client, APIPathConsumerGroups.Format(*client, 3, consumerGroupID, "lags"))
+}
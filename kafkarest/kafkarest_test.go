@@ -0,0 +1,183 @@
+package kafkarest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/telemetrytest"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(handler http.HandlerFunc) (*httptest.Server, *KafkaRESTClient) {
+	srv := httptest.NewServer(handler)
+	c := New(srv.URL+"/", "lkc-1", "key", "secret")
+	return srv, &c
+}
+
+func topicsHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Query().Get("page_token") {
+	case "":
+		w.Write([]byte(`{"kind":"KafkaTopicList","metadata":{"next":"http://` + r.Host + `/kafka/v3/clusters/lkc-1/topics?page_token=abc"},"data":[{"topic_name":"orders","partitions_count":2},{"topic_name":"_confluent-metrics","is_internal":true}]}`))
+	case "abc":
+		w.Write([]byte(`{"kind":"KafkaTopicList","metadata":{},"data":[{"topic_name":"payments","partitions_count":1},{"topic_name":"audit","partitions_count":1}]}`))
+	}
+}
+
+func TestTopics(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /kafka/v3/clusters/lkc-1/topics":
+			topicsHandler(w, r)
+		case "POST /kafka/v3/clusters/lkc-1/topics":
+			assert.JSONEq(`{"topic_name":"refunds","partitions_count":6,"configs":[{"name":"cleanup.policy","value":"compact"}]}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"topic_name":"refunds","partitions_count":6,"replication_factor":3}`))
+		case "GET /kafka/v3/clusters/lkc-1/topics/orders/partitions":
+			w.Write([]byte(`{"data":[{"topic_name":"orders","partition_id":0},{"topic_name":"orders","partition_id":1}]}`))
+		case "DELETE /kafka/v3/clusters/lkc-1/topics/refunds":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	topics, err := c.ListTopics()
+	assert.NoError(err)
+	assert.Len(topics, 4, "All pages should be followed")
+	assert.True(topics[1].IsInternal)
+
+	topic, err := c.CreateTopic(TopicSpec{TopicName: "refunds", PartitionsCount: 6, Configs: []ConfigValue{{Name: "cleanup.policy", Value: "compact"}}})
+	assert.NoError(err)
+	assert.Equal(3, topic.ReplicationFactor)
+
+	partitions, err := c.ListPartitions("orders")
+	assert.NoError(err)
+	assert.Len(partitions, 2)
+	assert.Equal(1, partitions[1].PartitionID)
+
+	assert.NoError(c.DeleteTopic("refunds"))
+}
+
+func TestTopics_Resolver(t *testing.T) {
+	assert := assert.New(t)
+
+	hosts := []string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.Host)
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			w.Write([]byte(`{"metadata":{"next":"https://pkc-1.us-east-1.aws.confluent.cloud/kafka/v3/clusters/lkc-1/topics?page_token=abc"},"data":[{"topic_name":"orders"}]}`))
+		case "abc":
+			w.Write([]byte(`{"metadata":{},"data":[{"topic_name":"payments"}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := New("https://pkc-1.us-east-1.aws.confluent.cloud", "lkc-1", "key", "secret")
+	c.Context.Resolver = client.NewEndpoints().SetResource(client.ServiceKafkaREST, "lkc-1", srv.URL)
+
+	topics, err := c.ListTopics()
+	assert.NoError(err)
+	assert.Len(topics, 2, "All pages should be followed")
+	assert.Len(hosts, 2, "Every page should be sent through the resolved endpoint, not the next link's host")
+}
+
+func TestConfigs(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /kafka/v3/clusters/lkc-1/topics/orders/configs":
+			w.Write([]byte(`{"data":[{"name":"retention.ms","value":"604800000","is_default":true,"source":"DEFAULT_CONFIG"},{"name":"sasl.jaas.config","value":null,"is_sensitive":true}]}`))
+		case "POST /kafka/v3/clusters/lkc-1/topics/orders/configs:alter":
+			assert.JSONEq(`{"data":[{"name":"retention.ms","value":"86400000"},{"name":"cleanup.policy","operation":"DELETE"}]}`, string(body))
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /kafka/v3/clusters/lkc-1/brokers/1/configs":
+			w.Write([]byte(`{"data":[{"broker_id":1,"name":"num.io.threads","value":"8"}]}`))
+		case "POST /kafka/v3/clusters/lkc-1/brokers/1/configs:alter":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	configs, err := c.GetTopicConfigs("orders")
+	assert.NoError(err)
+	assert.Equal("604800000", *configs[0].Value)
+	assert.Nil(configs[1].Value, "Sensitive configs have no value")
+
+	assert.NoError(c.AlterTopicConfigs("orders", ConfigValue{Name: "retention.ms", Value: "86400000"}, ConfigValue{Name: "cleanup.policy", Operation: OpDelete}))
+
+	configs, err = c.GetBrokerConfigs(1)
+	assert.NoError(err)
+	assert.Equal(1, *configs[0].BrokerID)
+	assert.NoError(c.AlterBrokerConfigs(1, ConfigValue{Name: "num.io.threads", Value: "16"}))
+}
+
+func TestConsumerGroups(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/kafka/v3/clusters/lkc-1/consumer-groups":
+			w.Write([]byte(`{"data":[{"consumer_group_id":"billing","state":"STABLE"}]}`))
+		case "/kafka/v3/clusters/lkc-1/consumer-groups/billing/lags":
+			w.Write([]byte(`{"data":[{"consumer_group_id":"billing","topic_name":"orders","partition_id":0,"current_offset":90,"log_end_offset":100,"lag":10}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	groups, err := c.ListConsumerGroups()
+	assert.NoError(err)
+	assert.Equal("STABLE", groups[0].State)
+
+	lags, err := c.GetConsumerLags("billing")
+	assert.NoError(err)
+	assert.Equal(int64(10), lags[0].Lag)
+}
+
+func TestIdleTopics(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(topicsHandler)
+	defer srv.Close()
+
+	now := time.Now().UTC().Truncate(time.Hour)
+	telemetrySrv := telemetrytest.NewServer()
+	defer telemetrySrv.Close()
+	received := metric.KafkaServerReceivedBytes.Name
+	telemetrySrv.Add(
+		telemetrytest.NewPoint(received, now.Add(-48*time.Hour), 100, "resource.kafka.id", "lkc-1", "metric.topic", "orders"),
+		telemetrytest.NewPoint(received, now.Add(-24*time.Hour), 0, "resource.kafka.id", "lkc-1", "metric.topic", "payments"),
+		telemetrytest.NewPoint(received, now.Add(-24*time.Hour), 50, "resource.kafka.id", "lkc-2", "metric.topic", "audit"),
+	)
+	tc := telemetry.New("key", "secret")
+	tc.Context.BaseURL = telemetrySrv.URL
+
+	idle, err := c.IdleTopics(&tc, interval.EndingAt(7*24*time.Hour, now))
+	assert.NoError(err)
+	assert.Len(idle, 2, "Internal topics and topics with traffic shouldn't be idle")
+	assert.Equal("payments", idle[0].TopicName, "Topics with zero bytes should be idle")
+	assert.Equal("audit", idle[1].TopicName, "Topics without data, or only data for other clusters, should be idle")
+
+	telemetrySrv.Add(telemetrytest.NewPoint(received, now.Add(-72*time.Hour), 0, "resource.kafka.id", "lkc-1", "metric.topic", "audit"))
+	tc.PageLimit = 1
+	paged, err := c.IdleTopics(&tc, interval.EndingAt(7*24*time.Hour, now))
+	assert.NoError(err)
+	assert.Equal(idle, paged, "Topics with traffic on later pages shouldn't be idle")
+}
@@ -0,0 +1,42 @@
+package kafkarest
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+)
+
+//IdleTopics returns the non internal Topics that exist in the Cluster, but have received zero bytes within a window of time, such as `interval.EndingAt(7*24*time.Hour, time.Now())`
+func (client *KafkaRESTClient) IdleTopics(telemetryClient *telemetry.TelemetryClient, inter interval.Interval) ([]Topic, error) {
+	return client.TopicsWithoutData(telemetryClient, metric.KafkaServerReceivedBytes, inter)
+}
+
+//TopicsWithoutData returns the non internal Topics that exist in the Cluster, but have a zero or missing value for a topic level metric within a window of time
+func (client *KafkaRESTClient) TopicsWithoutData(telemetryClient *telemetry.TelemetryClient, m metric.Metric, inter interval.Interval) ([]Topic, error) {
+	topics, err := client.ListTopics()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := telemetryClient.QueryKafkaMetricForAllTopics(client.ClusterID, granularity.OneDay, inter, m)
+	if err != nil {
+		return nil, err
+	}
+
+	totals := map[string]float64{}
+	for _, d := range data {
+		if topic, ok := d.Label(labels.MetricTopic); ok {
+			totals[topic] += d.Value
+		}
+	}
+
+	idle := []Topic{}
+	for _, t := range topics {
+		if !t.IsInternal && totals[t.TopicName] == 0 {
+			idle = append(idle, t)
+		}
+	}
+	return idle, nil
+}
@@ -0,0 +1,86 @@
+package kafkarest
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+)
+
+//Relationship is a link to a related resource of the Kafka REST API
+type Relationship struct {
+	Related string `json:"related"`
+}
+
+//Topic is a Kafka Topic
+type Topic struct {
+	Kind              string                  `json:"kind,omitempty"`
+	Metadata          response.ObjectMetadata `json:"metadata,omitempty"`
+	ClusterID         string                  `json:"cluster_id"`
+	TopicName         string                  `json:"topic_name"`
+	IsInternal        bool                    `json:"is_internal"`
+	ReplicationFactor int                     `json:"replication_factor"`
+	PartitionsCount   int                     `json:"partitions_count"`
+	Partitions        Relationship            `json:"partitions"`
+	Configs           Relationship            `json:"configs"`
+}
+
+//TopicList is a single page of Topics
+type TopicList struct {
+	Kind     string                `json:"kind,omitempty"`
+	Metadata response.ListMetadata `json:"metadata"`
+	Data     []Topic               `json:"data"`
+}
+
+//TopicSpec is the request body for creating a Topic. Zero partitions or replication factor use the Cluster's defaults
+type TopicSpec struct {
+	TopicName         string        `json:"topic_name"`
+	PartitionsCount   int           `json:"partitions_count,omitempty"`
+	ReplicationFactor int           `json:"replication_factor,omitempty"`
+	Configs           []ConfigValue `json:"configs,omitempty"`
+}
+
+//Partition is a single Partition of a Kafka Topic
+type Partition struct {
+	Kind         string                  `json:"kind,omitempty"`
+	Metadata     response.ObjectMetadata `json:"metadata,omitempty"`
+	ClusterID    string                  `json:"cluster_id"`
+	TopicName    string                  `json:"topic_name"`
+	PartitionID  int                     `json:"partition_id"`
+	Leader       Relationship            `json:"leader"`
+	Replicas     Relationship            `json:"replicas"`
+	Reassignment Relationship            `json:"reassignment"`
+}
+
+//PartitionList is a single page of Partitions
+type PartitionList struct {
+	Kind     string                `json:"kind,omitempty"`
+	Metadata response.ListMetadata `json:"metadata"`
+	Data     []Partition           `json:"data"`
+}
+
+//ListTopics returns all the Topics, across all pages, within the Cluster
+func (client *KafkaRESTClient) ListTopics() ([]Topic, error) {
+	return listAll[Topic](&client.Client, APIPathTopics.Format(*client, 3))
+}
+
+//GetTopic returns a single Topic by its name
+func (client *KafkaRESTClient) GetTopic(topicName string) (Topic, error) {
+	topic := Topic{}
+	err := client.Get(&topic, APIPathTopics.Format(*client, 3, topicName))
+	return topic, err
+}
+
+//CreateTopic creates a new Topic
+func (client *KafkaRESTClient) CreateTopic(spec TopicSpec) (Topic, error) {
+	topic := Topic{}
+	err := client.Post(&topic, APIPathTopics.Format(*client, 3), spec)
+	return topic, err
+}
+
+//DeleteTopic deletes a Topic and all its data
+func (client *KafkaRESTClient) DeleteTopic(topicName string) error {
+	return client.Delete(nil, APIPathTopics.Format(*client, 3, topicName))
+}
+
+//ListPartitions returns all the Partitions, across all pages, of a Topic
+func (client *KafkaRESTClient) ListPartitions(topicName string) ([]Partition, error) {
+	return listAll[Partition](&client.Client, APIPathTopics.Format(*client, 3, topicName, "partitions"))
+}
@@ -0,0 +1,44 @@
+package ksql
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+)
+
+const (
+	APIPathClusters KSQLAPIPath = "ksqldbcm/v%d/clusters"
+
+	APIPathStatements  client.APIPath = "ksql"
+	APIPathQueryStream client.APIPath = "query-stream"
+	APIPathCloseQuery  client.APIPath = "close-query"
+)
+
+//KSQLAPIPath is a path of the ksqlDB Cluster Management API, versioned within the path such as `ksqldbcm/v2/clusters`
+type KSQLAPIPath client.APIPath
+
+//Format builds the full URL of the path, scoped to the given Environment, with any IDs appended as sub paths
+func (p KSQLAPIPath) Format(client KSQLClient, apiVersion int8, environment string, ids ...string) string {
+	return p.unscoped(client, apiVersion, ids...) + "?" + environmentParams(environment).Encode()
+}
+
+//unscoped builds the full URL of the path, without the Environment param
+func (p KSQLAPIPath) unscoped(client KSQLClient, apiVersion int8, ids ...string) string {
+	parts := []string{client.Context.ResolveBaseURL(), fmt.Sprintf(string(p), apiVersion)}
+	for _, id := range ids {
+		parts = append(parts, url.PathEscape(id))
+	}
+	return strings.Join(parts, "/")
+}
+
+//environmentParams are the params that scope a request to an Environment
+func environmentParams(environment string) url.Values {
+	return url.Values{"environment": {environment}}
+}
+
+//clusterURL builds the full URL of a path of a ksqlDB Cluster's REST API, which unlike the Cloud APIs isn't versioned
+func clusterURL(c ClusterClient, p client.APIPath) string {
+	return c.Context.ResolveBaseURL() + "/" + string(p)
+}
@@ -0,0 +1,45 @@
+package ksql
+
+import (
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/authenticater"
+)
+
+const (
+	//DefaultBaseURL is the default Domain and Protocol for the Confluent Cloud APIs
+	DefaultBaseURL string = client.DefaultCloudBaseURL
+	//DefaultPageSize is the default number of results to request per page when listing
+	DefaultPageSize int = 100
+)
+
+//KSQLClient is the SDK Client for managing Confluent Cloud ksqlDB Clusters
+type KSQLClient struct {
+	client.Client
+	PageSize int
+}
+
+//New Used to create a new KSQLClient from a Cloud API Key and Secret
+func New(apiKey string, apiSecret string) KSQLClient {
+	c := KSQLClient{
+		PageSize: DefaultPageSize,
+		Client:   client.New(authenticater.NewAPIKeyAuth(apiKey, apiSecret), DefaultBaseURL, client.ErrorResponseHandler),
+	}
+	c.Context.Endpoint = client.Endpoint{Service: client.ServiceCloud}
+	return c
+}
+
+//ClusterClient is the SDK Client for the REST API of a single ksqlDB Cluster, used to execute statements and run queries
+type ClusterClient struct {
+	client.Client
+}
+
+//NewClusterClient Used to create a new ClusterClient from the Cluster's REST endpoint, such as the `http_endpoint` of a Cluster's status, the Cluster's ID, and a ksqlDB API Key and Secret
+func NewClusterClient(endpoint string, clusterID string, apiKey string, apiSecret string) ClusterClient {
+	c := ClusterClient{
+		Client: client.New(authenticater.NewAPIKeyAuth(apiKey, apiSecret), strings.TrimSuffix(endpoint, "/"), ErrorResponseHandler),
+	}
+	c.Context.Endpoint = client.Endpoint{Service: client.ServiceKSQL, ResourceID: clusterID}
+	return c
+}
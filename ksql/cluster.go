@@ -0,0 +1,90 @@
+package ksql
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+)
+
+const (
+	//KindCluster is the kind of ksqlDB Cluster resources
+	KindCluster string = "Cluster"
+
+	//PhaseProvisioning is the phase of a Cluster that is still being created
+	PhaseProvisioning string = "PROVISIONING"
+	//PhaseProvisioned is the phase of a Cluster that is ready for use
+	PhaseProvisioned string = "PROVISIONED"
+	//PhaseFailed is the phase of a Cluster that failed to provision
+	PhaseFailed string = "FAILED"
+)
+
+//Cluster is a Confluent Cloud ksqlDB Cluster
+type Cluster struct {
+	APIVersion string                  `json:"api_version,omitempty"`
+	Kind       string                  `json:"kind,omitempty"`
+	ID         string                  `json:"id,omitempty"`
+	Metadata   response.ObjectMetadata `json:"metadata,omitempty"`
+	Spec       ClusterSpec             `json:"spec"`
+	Status     ClusterStatus           `json:"status,omitempty"`
+}
+
+//ClusterSpec is the desired state of a ksqlDB Cluster
+type ClusterSpec struct {
+	DisplayName              string                    `json:"display_name,omitempty"`
+	CSU                      int                       `json:"csu,omitempty"`
+	UseDetailedProcessingLog *bool                     `json:"use_detailed_processing_log,omitempty"`
+	KafkaCluster             *response.ObjectReference `json:"kafka_cluster,omitempty"`
+	CredentialIdentity       *response.ObjectReference `json:"credential_identity,omitempty"`
+	Environment              *response.ObjectReference `json:"environment,omitempty"`
+}
+
+//ClusterStatus is the observed state of a ksqlDB Cluster
+type ClusterStatus struct {
+	Phase        string `json:"phase,omitempty"`
+	HTTPEndpoint string `json:"http_endpoint,omitempty"`
+	TopicPrefix  string `json:"topic_prefix,omitempty"`
+	Storage      int    `json:"storage,omitempty"`
+}
+
+//ClusterList is a single page of ksqlDB Clusters
+type ClusterList = client.ListPage[Cluster]
+
+//TelemetryFilter returns a Telemetry filter selecting the data points of this Cluster
+func (c Cluster) TelemetryFilter() filter.FieldFilter {
+	return filter.EqualTo(labels.ResourceKSQL, c.ID)
+}
+
+//ClusterIDs returns the IDs of the Clusters, such as for use with `TelemetryClient.QueryKSQLMetricForApps`
+func ClusterIDs(clusters ...Cluster) []string {
+	ids := make([]string, len(clusters))
+	for i, c := range clusters {
+		ids[i] = c.ID
+	}
+	return ids
+}
+
+//clusters is the Resource of the ksqlDB Clusters within an Environment
+func clusters(c *KSQLClient, environment string) client.Resource[Cluster] {
+	return client.NewResource[Cluster](&c.Client, APIPathClusters.unscoped(*c, 2), environmentParams(environment), c.PageSize)
+}
+
+//Clusters returns the Resource of the ksqlDB Clusters within an Environment, such as to iterate over them with List
+func (client *KSQLClient) Clusters(environment string) client.Resource[Cluster] {
+	return clusters(client, environment)
+}
+
+//ListClustersPage returns a single page of ksqlDB Clusters within an Environment. An empty page token fetches the first page
+func (client *KSQLClient) ListClustersPage(environment string, pageToken string) (ClusterList, error) {
+	return clusters(client, environment).ListPage(pageToken)
+}
+
+//ListClusters returns all the ksqlDB Clusters, across all pages, within an Environment
+func (client *KSQLClient) ListClusters(environment string) ([]Cluster, error) {
+	return clusters(client, environment).ListAll()
+}
+
+//GetCluster returns a single ksqlDB Cluster by its ID, such as `lksqlc-abc123`
+func (client *KSQLClient) GetCluster(environment string, id string) (Cluster, error) {
+	return clusters(client, environment).Get(id)
+}
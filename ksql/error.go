@@ -0,0 +1,25 @@
+package ksql
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//ErrorResponse is the JSON error body returned by a ksqlDB Cluster, either for the whole request or for a single statement
+type ErrorResponse struct {
+	Type          string `json:"@type"`
+	ErrorCode     int    `json:"error_code"`
+	Message       string `json:"message"`
+	StatementText string `json:"statementText,omitempty"`
+}
+
+func (err ErrorResponse) Error() string {
+	return fmt.Sprintf("KSQLError(%d - %s)", err.ErrorCode, err.Message)
+}
+
+//ErrorResponseHandler is a HTTPErrorHandler that decodes the ksqlDB JSON error body
+func ErrorResponseHandler(statusCode int, body []byte) error {
+	err := ErrorResponse{ErrorCode: statusCode}
+	json.Unmarshal(body, &err)
+	return err
+}
@@ -0,0 +1,195 @@
+package ksql
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/telemetrytest"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClusterClient(handler http.HandlerFunc) (*httptest.Server, *ClusterClient) {
+	srv := httptest.NewServer(handler)
+	c := NewClusterClient(srv.URL+"/", "lksqlc-1", "ksql-key", "ksql-secret")
+	return srv, &c
+}
+
+func TestListClusters(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("env-1", r.URL.Query().Get("environment"))
+		switch r.URL.Path {
+		case "/ksqldbcm/v2/clusters":
+			w.Write([]byte(`{"data":[{"id":"lksqlc-1","spec":{"display_name":"orders","csu":4},"status":{"phase":"PROVISIONED","http_endpoint":"https://pksqlc-1.us-west-2.aws.confluent.cloud"}}]}`))
+		case "/ksqldbcm/v2/clusters/lksqlc-1":
+			w.Write([]byte(`{"id":"lksqlc-1","spec":{"display_name":"orders","csu":4}}`))
+		}
+	}))
+	defer srv.Close()
+	c := New("key", "secret")
+	c.Context.BaseURL = srv.URL
+
+	clusters, err := c.ListClusters("env-1")
+	assert.NoError(err)
+	assert.Len(clusters, 1)
+	assert.Equal(4, clusters[0].Spec.CSU)
+	assert.Equal("https://pksqlc-1.us-west-2.aws.confluent.cloud", clusters[0].Status.HTTPEndpoint)
+
+	cluster, err := c.GetCluster("env-1", "lksqlc-1")
+	assert.NoError(err)
+	assert.Equal("orders", cluster.Spec.DisplayName)
+}
+
+func TestExecuteStatement(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClusterClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/ksql", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		switch string(body) {
+		case `{"ksql":"SHOW STREAMS;"}`:
+			w.Write([]byte(`[{"@type":"streams","statementText":"SHOW STREAMS;","streams":[{"type":"STREAM","name":"ORDERS","topic":"orders","keyFormat":"KAFKA","valueFormat":"AVRO"}]}]`))
+		case `{"ksql":"SHOW TABLES;"}`:
+			w.Write([]byte(`[{"@type":"tables","statementText":"SHOW TABLES;","tables":[{"type":"TABLE","name":"ORDERS_BY_ID","isWindowed":false}]}]`))
+		case `{"ksql":"SHOW QUERIES;"}`:
+			w.Write([]byte(`[{"@type":"queries","statementText":"SHOW QUERIES;","queries":[{"id":"CTAS_ORDERS_BY_ID_1","state":"RUNNING","sinks":["ORDERS_BY_ID"]}]}]`))
+		case `{"ksql":"DROP STREAM ORDERS;","streamsProperties":{"ksql.streams.auto.offset.reset":"earliest"}}`:
+			w.Write([]byte(`[{"@type":"currentStatus","statementText":"DROP STREAM ORDERS;","commandStatus":{"status":"SUCCESS","message":"Source ORDERS was dropped."}}]`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"@type":"statement_error","error_code":40001,"message":"line 1:1: mismatched input","statementText":"SELEC"}`))
+		}
+	})
+	defer srv.Close()
+
+	streams, err := c.ListStreams()
+	assert.NoError(err)
+	assert.Equal("ORDERS", streams[0].Name)
+
+	tables, err := c.ListTables()
+	assert.NoError(err)
+	assert.Equal("ORDERS_BY_ID", tables[0].Name)
+
+	queries, err := c.ListQueries()
+	assert.NoError(err)
+	assert.Equal("RUNNING", queries[0].State)
+
+	responses, err := c.ExecuteStatement("DROP STREAM ORDERS;", map[string]string{"ksql.streams.auto.offset.reset": "earliest"})
+	assert.NoError(err)
+	assert.Equal(TypeCurrentStatus, responses[0].Type)
+	assert.Equal("SUCCESS", responses[0].CommandStatus.Status)
+
+	_, err = c.ExecuteStatement("SELEC", nil)
+	assert.Error(err)
+	assert.Contains(err.Error(), "40001")
+}
+
+func TestStreamQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	release := make(chan bool)
+	srv, c := newTestClusterClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/query-stream", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(`{"sql":"SELECT * FROM ORDERS EMIT CHANGES;"}`, string(body))
+
+		w.Write([]byte(`[{"queryId":"transient_1","columnNames":["ID","AMOUNT"],"columnTypes":["STRING","DOUBLE"]},` + "\n"))
+		w.Write([]byte(`["a",1.5],` + "\n"))
+		w.(http.Flusher).Flush()
+		<-release
+		w.Write([]byte(`["b",2.5],` + "\n"))
+		w.Write([]byte(`{"@type":"generic_error","error_code":50000,"message":"Query terminated"}]`))
+	})
+	defer srv.Close()
+
+	stream, err := c.StreamQuery(context.Background(), "SELECT * FROM ORDERS EMIT CHANGES;", nil)
+	assert.NoError(err)
+	defer stream.Close()
+	assert.Equal("transient_1", stream.Header.QueryID)
+	assert.Equal([]string{"ID", "AMOUNT"}, stream.Header.ColumnNames)
+
+	row, err := stream.Next()
+	assert.NoError(err, "Rows should be decoded as they arrive, before the response completes")
+	assert.Equal(Row{"a", 1.5}, row)
+	close(release)
+
+	row, err = stream.Next()
+	assert.NoError(err)
+	assert.Equal(Row{"b", 2.5}, row)
+
+	_, err = stream.Next()
+	assert.Equal(50000, err.(ErrorResponse).ErrorCode, "Errors mid stream should be returned")
+}
+
+func TestQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClusterClient(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"columnNames":["ID"],"columnTypes":["STRING"]},["a"],["b"]]`))
+	})
+	defer srv.Close()
+
+	result, err := c.Query(context.Background(), "SELECT ID FROM ORDERS_BY_ID;", nil)
+	assert.NoError(err)
+	assert.Len(result.Rows, 2)
+
+	stream, err := c.StreamQuery(context.Background(), "SELECT ID FROM ORDERS_BY_ID;", nil)
+	assert.NoError(err)
+	stream.Next()
+	stream.Next()
+	_, err = stream.Next()
+	assert.Equal(io.EOF, err)
+}
+
+func TestCSUUtilization(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now().UTC().Truncate(time.Hour)
+	srv := telemetrytest.NewServer()
+	defer srv.Close()
+	csu := metric.KSQLStreamingUnitCount.Name
+	srv.Add(
+		telemetrytest.NewPoint(csu, now.Add(-3*time.Hour), 4, "resource.ksql.id", "lksqlc-1"),
+		telemetrytest.NewPoint(csu, now.Add(-2*time.Hour), 2, "resource.ksql.id", "lksqlc-1"),
+	)
+	tc := telemetry.New("key", "secret")
+	tc.Context.BaseURL = srv.URL
+
+	clusters := []Cluster{
+		{ID: "lksqlc-1", Spec: ClusterSpec{CSU: 4}},
+		{ID: "lksqlc-2", Spec: ClusterSpec{CSU: 8}},
+	}
+	usages, err := CSUUtilization(&tc, clusters, interval.EndingAt(24*time.Hour, now))
+	assert.NoError(err)
+	assert.Len(usages, 2)
+	assert.Equal(3.0, usages[0].Average)
+	assert.Equal(4.0, usages[0].Max)
+	assert.Equal(6.0, usages[0].Hours)
+	assert.Equal(0.75, usages[0].Utilization())
+	assert.Equal(0.0, usages[1].Utilization(), "Clusters without data should report no usage")
+}
+
+func TestClusterClientEndpoint(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/ksql", r.URL.Path)
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	c := NewClusterClient("https://pksqlc-public.us-west-2.aws.confluent.cloud", "lksqlc-1", "ksql-key", "ksql-secret")
+	c.Context.Resolver = client.NewEndpoints().SetResource(client.ServiceKSQL, "lksqlc-1", srv.URL)
+	_, err := c.ExecuteStatement("SHOW STREAMS;", nil)
+	assert.NoError(err, "The Cluster's override should be used in place of its endpoint")
+}
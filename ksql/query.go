@@ -0,0 +1,143 @@
+package ksql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+//QueryHeader describes the rows returned by a query
+type QueryHeader struct {
+	QueryID     string   `json:"queryId,omitempty"`
+	ColumnNames []string `json:"columnNames"`
+	ColumnTypes []string `json:"columnTypes"`
+}
+
+//Row is a single row returned by a query, with a value for each of the header's columns. Numbers are decoded as float64
+type Row []interface{}
+
+//QueryResult is all the rows returned by a pull query
+type QueryResult struct {
+	Header QueryHeader
+	Rows   []Row
+}
+
+//QueryStream is the incrementally decoded results of a query, as they're streamed from the ksqlDB Cluster
+type QueryStream struct {
+	Header QueryHeader
+	body   io.ReadCloser
+	dec    *json.Decoder
+}
+
+//queryRequest is the request body for running a query
+type queryRequest struct {
+	SQL        string            `json:"sql"`
+	Properties map[string]string `json:"properties,omitempty"`
+}
+
+//StreamQuery runs a push or pull query, such as `SELECT * FROM orders EMIT CHANGES;`, returning once the header has been received.
+//Rows are then decoded as they arrive with Next. The query runs until the stream is closed, the context is done or, for pull queries, all rows are returned
+func (client *ClusterClient) StreamQuery(ctx context.Context, sql string, properties map[string]string) (*QueryStream, error) {
+	body, err := json.Marshal(queryRequest{SQL: sql, Properties: properties})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.Stream(ctx, "POST", clusterURL(*client, APIPathQueryStream), body)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &QueryStream{
+		body: res,
+		dec:  json.NewDecoder(res),
+	}
+	if err := stream.readHeader(); err != nil {
+		res.Close()
+		return nil, err
+	}
+	return stream, nil
+}
+
+//Query runs a pull query, such as `SELECT * FROM orders_by_id WHERE id = 1;`, returning all of its rows
+func (client *ClusterClient) Query(ctx context.Context, sql string, properties map[string]string) (QueryResult, error) {
+	stream, err := client.StreamQuery(ctx, sql, properties)
+	if err != nil {
+		return QueryResult{}, err
+	}
+	defer stream.Close()
+
+	result := QueryResult{Header: stream.Header, Rows: []Row{}}
+	for {
+		row, err := stream.Next()
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return result, err
+		}
+		result.Rows = append(result.Rows, row)
+	}
+}
+
+//CloseQuery terminates a push query by its ID, as returned in the QueryStream's header
+func (client *ClusterClient) CloseQuery(queryID string) error {
+	return client.Post(nil, clusterURL(*client, APIPathCloseQuery), QueryHeader{QueryID: queryID})
+}
+
+//readHeader reads the opening of the JSON array of results, followed by the header
+func (s *QueryStream) readHeader() error {
+	tok, err := s.dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return errors.New("Query results weren't a JSON array")
+	}
+	if !s.dec.More() {
+		return errors.New("Query results are missing their header")
+	}
+
+	raw := json.RawMessage{}
+	if err := s.dec.Decode(&raw); err != nil {
+		return err
+	}
+	if err := decodeError(raw); err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, &s.Header)
+}
+
+//Next returns the next row, blocking until it arrives. io.EOF is returned once all the rows have been read
+func (s *QueryStream) Next() (Row, error) {
+	if !s.dec.More() {
+		return nil, io.EOF
+	}
+
+	raw := json.RawMessage{}
+	if err := s.dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+	if err := decodeError(raw); err != nil {
+		return nil, err
+	}
+
+	row := Row{}
+	err := json.Unmarshal(raw, &row)
+	return row, err
+}
+
+//Close stops reading results, ending the query
+func (s *QueryStream) Close() error {
+	return s.body.Close()
+}
+
+//decodeError returns the error within the results, which ksqlDB sends as an object in place of a row when a query fails mid stream
+func decodeError(raw json.RawMessage) error {
+	errRes := ErrorResponse{}
+	if json.Unmarshal(raw, &errRes) != nil || errRes.Type == "" {
+		return nil
+	}
+	return errRes
+}
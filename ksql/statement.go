@@ -0,0 +1,102 @@
+package ksql
+
+import (
+	"fmt"
+)
+
+const (
+	//TypeStreams is the `@type` of the response to `SHOW STREAMS`
+	TypeStreams string = "streams"
+	//TypeTables is the `@type` of the response to `SHOW TABLES`
+	TypeTables string = "tables"
+	//TypeQueries is the `@type` of the response to `SHOW QUERIES`
+	TypeQueries string = "queries"
+	//TypeCurrentStatus is the `@type` of the response to statements that create, alter or drop resources
+	TypeCurrentStatus string = "currentStatus"
+)
+
+//StatementResponse is the result of a single executed statement. Which fields are set depends on its Type
+type StatementResponse struct {
+	Type          string         `json:"@type"`
+	StatementText string         `json:"statementText"`
+	Warnings      []Warning      `json:"warnings,omitempty"`
+	CommandID     string         `json:"commandId,omitempty"`
+	CommandStatus *CommandStatus `json:"commandStatus,omitempty"`
+	Streams       []Source       `json:"streams,omitempty"`
+	Tables        []Source       `json:"tables,omitempty"`
+	Queries       []RunningQuery `json:"queries,omitempty"`
+}
+
+//Warning is a warning raised while executing a statement
+type Warning struct {
+	Message string `json:"message"`
+}
+
+//CommandStatus is the status of a statement that creates, alters or drops resources
+type CommandStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+//Source is a ksqlDB Stream or Table
+type Source struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Topic       string `json:"topic"`
+	KeyFormat   string `json:"keyFormat"`
+	ValueFormat string `json:"valueFormat"`
+	IsWindowed  bool   `json:"isWindowed"`
+}
+
+//RunningQuery is a persistent or push query running on the ksqlDB Cluster
+type RunningQuery struct {
+	ID          string   `json:"id"`
+	QueryString string   `json:"queryString"`
+	Sinks       []string `json:"sinks"`
+	SinkTopics  []string `json:"sinkKafkaTopics"`
+	QueryType   string   `json:"queryType"`
+	State       string   `json:"state"`
+}
+
+//statementRequest is the request body for executing statements
+type statementRequest struct {
+	KSQL       string            `json:"ksql"`
+	Properties map[string]string `json:"streamsProperties,omitempty"`
+}
+
+//ExecuteStatement executes one or more `;` terminated statements, such as `CREATE STREAM`, returning the result of each statement.
+//Queries, which return rows, must instead use Query or StreamQuery
+func (client *ClusterClient) ExecuteStatement(ksql string, properties map[string]string) ([]StatementResponse, error) {
+	responses := []StatementResponse{}
+	err := client.Post(&responses, clusterURL(*client, APIPathStatements), statementRequest{KSQL: ksql, Properties: properties})
+	return responses, err
+}
+
+//ListStreams returns all the Streams of the ksqlDB Cluster
+func (client *ClusterClient) ListStreams() ([]Source, error) {
+	res, err := client.show("SHOW STREAMS;", TypeStreams)
+	return res.Streams, err
+}
+
+//ListTables returns all the Tables of the ksqlDB Cluster
+func (client *ClusterClient) ListTables() ([]Source, error) {
+	res, err := client.show("SHOW TABLES;", TypeTables)
+	return res.Tables, err
+}
+
+//ListQueries returns all the persistent and push queries running on the ksqlDB Cluster
+func (client *ClusterClient) ListQueries() ([]RunningQuery, error) {
+	res, err := client.show("SHOW QUERIES;", TypeQueries)
+	return res.Queries, err
+}
+
+func (client *ClusterClient) show(statement string, expectedType string) (StatementResponse, error) {
+	responses, err := client.ExecuteStatement(statement, nil)
+	if err != nil {
+		return StatementResponse{}, err
+	}
+	if len(responses) != 1 || responses[0].Type != expectedType {
+		return StatementResponse{}, fmt.Errorf("Unexpected response to `%s`", statement)
+	}
+	return responses[0], nil
+}
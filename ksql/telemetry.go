@@ -0,0 +1,52 @@
+package ksql
+
+import (
+	"math"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+)
+
+//CSUUsage is the Confluent Streaming Units (CSUs) a ksqlDB Cluster reported, as the `KSQLStreamingUnitCount` metric, compared to those provisioned
+type CSUUsage struct {
+	Cluster     Cluster
+	Provisioned int
+	Average     float64
+	Max         float64
+	//Hours is the total CSU hours reported over the window of time
+	Hours float64
+}
+
+//Utilization returns the average reported CSUs as a fraction of those provisioned
+func (u CSUUsage) Utilization() float64 {
+	if u.Provisioned <= 0 {
+		return 0
+	}
+	return u.Average / float64(u.Provisioned)
+}
+
+//CSUUtilization reports the CSUs of each ksqlDB Cluster within a window of time, from the `KSQLStreamingUnitCount` metric at an hourly granularity.
+//Clusters without any data points report zero usage
+func CSUUtilization(telemetryClient *telemetry.TelemetryClient, clusters []Cluster, inter interval.Interval) ([]CSUUsage, error) {
+	data, err := telemetryClient.QueryKSQLMetricForApps(ClusterIDs(clusters...), granularity.OneHour, inter, metric.KSQLStreamingUnitCount)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]CSUUsage, len(clusters))
+	for i, c := range clusters {
+		usage := CSUUsage{Cluster: c, Provisioned: c.Spec.CSU}
+		points := data[c.ID]
+		for _, d := range points {
+			usage.Hours += d.Value
+			usage.Max = math.Max(usage.Max, d.Value)
+		}
+		if len(points) > 0 {
+			usage.Average = usage.Hours / float64(len(points))
+		}
+		usages[i] = usage
+	}
+	return usages, nil
+}
@@ -0,0 +1,26 @@
+package org
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+)
+
+const (
+	APIPathEnvironments  OrgAPIPath = "org/v%d/environments"
+	APIPathOrganizations OrgAPIPath = "org/v%d/organizations"
+)
+
+//OrgAPIPath is a path of the Org API, versioned within the path such as `org/v2/environments`
+type OrgAPIPath client.APIPath
+
+//Format builds the full URL of the path, with any IDs appended as sub paths
+func (p OrgAPIPath) Format(client OrgClient, apiVersion int8, ids ...string) string {
+	parts := []string{client.Context.ResolveBaseURL(), fmt.Sprintf(string(p), apiVersion)}
+	for _, id := range ids {
+		parts = append(parts, url.PathEscape(id))
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,29 @@
+package org
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/authenticater"
+)
+
+const (
+	//DefaultBaseURL is the default Domain and Protocol for the Confluent Cloud APIs
+	DefaultBaseURL string = client.DefaultCloudBaseURL
+	//DefaultPageSize is the default number of results to request per page when listing
+	DefaultPageSize int = 100
+)
+
+//OrgClient is the SDK Client for managing Confluent Cloud Organizations and Environments
+type OrgClient struct {
+	client.Client
+	PageSize int
+}
+
+//New Used to create a new OrgClient from a Cloud API Key and Secret
+func New(apiKey string, apiSecret string) OrgClient {
+	c := OrgClient{
+		PageSize: DefaultPageSize,
+		Client:   client.New(authenticater.NewAPIKeyAuth(apiKey, apiSecret), DefaultBaseURL, client.ErrorResponseHandler),
+	}
+	c.Context.Endpoint = client.Endpoint{Service: client.ServiceCloud}
+	return c
+}
@@ -0,0 +1,68 @@
+package org
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+)
+
+const (
+	//KindEnvironment is the kind of Environment resources
+	KindEnvironment string = "Environment"
+)
+
+//Environment is a Confluent Cloud Environment, a grouping of clusters and other resources within an Organization
+type Environment struct {
+	APIVersion  string                  `json:"api_version,omitempty"`
+	Kind        string                  `json:"kind,omitempty"`
+	ID          string                  `json:"id,omitempty"`
+	Metadata    response.ObjectMetadata `json:"metadata,omitempty"`
+	DisplayName string                  `json:"display_name"`
+}
+
+//displayNameSpec is the request body for creating or renaming resources that only have a display name
+type displayNameSpec struct {
+	DisplayName string `json:"display_name"`
+}
+
+//EnvironmentList is a single page of Environments
+type EnvironmentList = client.ListPage[Environment]
+
+//environments is the Resource of the Environments within the Organization
+func environments(c *OrgClient) client.Resource[Environment] {
+	return client.NewResource[Environment](&c.Client, APIPathEnvironments.Format(*c, 2), nil, c.PageSize)
+}
+
+//Environments returns the Resource of the Environments within the Organization, such as to iterate over them with List
+func (client *OrgClient) Environments() client.Resource[Environment] {
+	return environments(client)
+}
+
+//ListEnvironmentsPage returns a single page of Environments. An empty page token fetches the first page
+func (client *OrgClient) ListEnvironmentsPage(pageToken string) (EnvironmentList, error) {
+	return environments(client).ListPage(pageToken)
+}
+
+//ListEnvironments returns all the Environments, across all pages, within the Organization
+func (client *OrgClient) ListEnvironments() ([]Environment, error) {
+	return environments(client).ListAll()
+}
+
+//GetEnvironment returns a single Environment by its ID, such as `env-abc123`
+func (client *OrgClient) GetEnvironment(id string) (Environment, error) {
+	return environments(client).Get(id)
+}
+
+//CreateEnvironment creates a new Environment with the given display name
+func (client *OrgClient) CreateEnvironment(displayName string) (Environment, error) {
+	return environments(client).Create(displayNameSpec{DisplayName: displayName})
+}
+
+//UpdateEnvironment renames an existing Environment
+func (client *OrgClient) UpdateEnvironment(id string, displayName string) (Environment, error) {
+	return environments(client).Update(id, displayNameSpec{DisplayName: displayName})
+}
+
+//DeleteEnvironment deletes an Environment. All resources within it must be deleted first
+func (client *OrgClient) DeleteEnvironment(id string) error {
+	return environments(client).Delete(id)
+}
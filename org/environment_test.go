@@ -0,0 +1,106 @@
+package org
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(handler http.HandlerFunc) (*httptest.Server, *OrgClient) {
+	srv := httptest.NewServer(handler)
+	c := New("key", "secret")
+	c.Context.BaseURL = srv.URL
+	return srv, &c
+}
+
+func TestListEnvironments(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/org/v2/environments", r.URL.Path)
+		assert.Equal("100", r.URL.Query().Get("page_size"))
+
+		list := EnvironmentList{Kind: "EnvironmentList"}
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			list.Data = []Environment{{ID: "env-1", DisplayName: "prod"}}
+			list.Metadata.Next = "https://api.confluent.cloud/org/v2/environments?page_size=100&page_token=abc"
+		case "abc":
+			list.Data = []Environment{{ID: "env-2", DisplayName: "dev"}}
+		}
+		json.NewEncoder(w).Encode(list)
+	})
+	defer srv.Close()
+
+	envs, err := c.ListEnvironments()
+	assert.NoError(err)
+	assert.Len(envs, 2, "All pages should be fetched")
+	assert.Equal("env-1", envs[0].ID)
+	assert.Equal("dev", envs[1].DisplayName)
+}
+
+func TestEnvironmentCRUD(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.Path {
+		case "POST /org/v2/environments":
+			assert.JSONEq(`{"display_name":"prod"}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"env-1","kind":"Environment","display_name":"prod","metadata":{"created_at":"2021-04-20T00:00:00Z"}}`))
+		case "GET /org/v2/environments/env-1":
+			w.Write([]byte(`{"id":"env-1","kind":"Environment","display_name":"prod"}`))
+		case "PATCH /org/v2/environments/env-1":
+			assert.JSONEq(`{"display_name":"production"}`, string(body))
+			w.Write([]byte(`{"id":"env-1","kind":"Environment","display_name":"production"}`))
+		case "DELETE /org/v2/environments/env-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[{"status":"404","detail":"Not Found"}]}`))
+		}
+	})
+	defer srv.Close()
+
+	env, err := c.CreateEnvironment("prod")
+	assert.NoError(err, "Any 2xx status should be a success")
+	assert.Equal("env-1", env.ID)
+	assert.Equal(2021, env.Metadata.CreatedAt.Year())
+
+	env, err = c.GetEnvironment("env-1")
+	assert.NoError(err)
+	assert.Equal("prod", env.DisplayName)
+
+	env, err = c.UpdateEnvironment("env-1", "production")
+	assert.NoError(err)
+	assert.Equal("production", env.DisplayName)
+
+	assert.NoError(c.DeleteEnvironment("env-1"), "A 204 without a body should be a success")
+
+	_, err = c.GetEnvironment("env-2")
+	assert.Error(err)
+	assert.Equal(http.StatusNotFound, err.(client.Error).HTTPStatusCode)
+	assert.Equal("Not Found", err.(client.Error).Unwrap().(response.ErrorResponse).Errors[0].Detail)
+}
+
+func TestGetOrganization(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/org/v2/organizations/org-1", r.URL.Path)
+		w.Write([]byte(`{"id":"org-1","kind":"Organization","display_name":"Acme","jit_enabled":true}`))
+	})
+	defer srv.Close()
+
+	org, err := c.GetOrganization("org-1")
+	assert.NoError(err)
+	assert.Equal("Acme", org.DisplayName)
+	assert.True(org.JITEnabled)
+}
@@ -0,0 +1,44 @@
+package org
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/response"
+)
+
+const (
+	//KindOrganization is the kind of Organization resources
+	KindOrganization string = "Organization"
+)
+
+//Organization is a Confluent Cloud Organization, the top level container of all Environments
+type Organization struct {
+	APIVersion  string                  `json:"api_version,omitempty"`
+	Kind        string                  `json:"kind,omitempty"`
+	ID          string                  `json:"id,omitempty"`
+	Metadata    response.ObjectMetadata `json:"metadata,omitempty"`
+	DisplayName string                  `json:"display_name"`
+	JITEnabled  bool                    `json:"jit_enabled,omitempty"`
+}
+
+//OrganizationList is a single page of Organizations
+type OrganizationList = client.ListPage[Organization]
+
+//organizations is the Resource of the Organizations the API Key has access to
+func organizations(c *OrgClient) client.Resource[Organization] {
+	return client.NewResource[Organization](&c.Client, APIPathOrganizations.Format(*c, 2), nil, c.PageSize)
+}
+
+//ListOrganizationsPage returns a single page of Organizations. An empty page token fetches the first page
+func (client *OrgClient) ListOrganizationsPage(pageToken string) (OrganizationList, error) {
+	return organizations(client).ListPage(pageToken)
+}
+
+//ListOrganizations returns all the Organizations, across all pages, the API Key has access to
+func (client *OrgClient) ListOrganizations() ([]Organization, error) {
+	return organizations(client).ListAll()
+}
+
+//GetOrganization returns a single Organization by its ID
+func (client *OrgClient) GetOrganization(id string) (Organization, error) {
+	return organizations(client).Get(id)
+}
@@ -0,0 +1,44 @@
+package schemaregistry
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+)
+
+const (
+	APIPathSubjects      SchemaRegistryAPIPath = "subjects"
+	APIPathSchemaIDs     SchemaRegistryAPIPath = "schemas/ids"
+	APIPathCompatibility SchemaRegistryAPIPath = "compatibility/subjects"
+	APIPathConfig        SchemaRegistryAPIPath = "config"
+)
+
+//SchemaRegistryAPIPath is a path of the Schema Registry API, which unlike the Cloud APIs isn't versioned
+type SchemaRegistryAPIPath client.APIPath
+
+//Format builds the full URL of the path, with any IDs appended as sub paths
+func (p SchemaRegistryAPIPath) Format(client SchemaRegistryClient, ids ...string) string {
+	parts := []string{client.Context.ResolveBaseURL(), string(p)}
+	for _, id := range ids {
+		parts = append(parts, url.PathEscape(id))
+	}
+	return strings.Join(parts, "/")
+}
+
+//versionID is the path form of a schema version, where LatestVersion is `latest`
+func versionID(version int) string {
+	if version == LatestVersion {
+		return "latest"
+	}
+	return strconv.Itoa(version)
+}
+
+//withFlag appends a boolean query param to a URL when it's set
+func withFlag(u string, flag string, set bool) string {
+	if !set {
+		return u
+	}
+	return u + "?" + flag + "=true"
+}
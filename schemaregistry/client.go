@@ -0,0 +1,34 @@
+package schemaregistry
+
+import (
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/authenticater"
+)
+
+const (
+	//ContentType is the media type of the Schema Registry API
+	ContentType string = "application/vnd.schemaregistry.v1+json"
+)
+
+//SchemaRegistryClient is the SDK Client for a Schema Registry cluster
+type SchemaRegistryClient struct {
+	client.Client
+}
+
+//New Used to create a new SchemaRegistryClient from the Schema Registry's endpoint, such as `https://psrc-abc123.us-east-2.aws.confluent.cloud`, and a Schema Registry API Key and Secret
+func New(endpoint string, apiKey string, apiSecret string) SchemaRegistryClient {
+	return NewInRegion(endpoint, "", "", apiKey, apiSecret)
+}
+
+//NewInRegion Used to create a new SchemaRegistryClient for the Schema Registry of a cloud region, such as `AWS` and `us-east-2`,
+//so that the region's overrides of an EndpointResolver, such as its Private Networking endpoint, are used in place of the given endpoint
+func NewInRegion(endpoint string, cloud string, region string, apiKey string, apiSecret string) SchemaRegistryClient {
+	c := SchemaRegistryClient{
+		Client: client.New(authenticater.NewAPIKeyAuth(apiKey, apiSecret), strings.TrimSuffix(endpoint, "/"), ErrorResponseHandler),
+	}
+	c.Context.HTTPHeaders = map[string]string{"Accept": ContentType}
+	c.Context.Endpoint = client.Endpoint{Service: client.ServiceSchemaRegistry, Cloud: cloud, Region: region}
+	return c
+}
@@ -0,0 +1,68 @@
+package schemaregistry
+
+//The compatibility levels a schema can be checked against when registered under a subject
+const (
+	CompatibilityBackward           string = "BACKWARD"
+	CompatibilityBackwardTransitive string = "BACKWARD_TRANSITIVE"
+	CompatibilityForward            string = "FORWARD"
+	CompatibilityForwardTransitive  string = "FORWARD_TRANSITIVE"
+	CompatibilityFull               string = "FULL"
+	CompatibilityFullTransitive     string = "FULL_TRANSITIVE"
+	CompatibilityNone               string = "NONE"
+)
+
+//CompatibilityResult is the result of checking a schema against a version of a subject
+type CompatibilityResult struct {
+	IsCompatible bool     `json:"is_compatible"`
+	Messages     []string `json:"messages,omitempty"`
+}
+
+//compatibilityConfig is the compatibility config of the Schema Registry or a subject. Reads return `compatibilityLevel`, where as writes use `compatibility`
+type compatibilityConfig struct {
+	CompatibilityLevel string `json:"compatibilityLevel,omitempty"`
+	Compatibility      string `json:"compatibility,omitempty"`
+}
+
+func (c compatibilityConfig) level() string {
+	if c.CompatibilityLevel != "" {
+		return c.CompatibilityLevel
+	}
+	return c.Compatibility
+}
+
+//CheckCompatibility checks if a schema is compatible with a version of a subject, under the subject's compatibility config. Use LatestVersion for the latest.
+//The result's messages explain any incompatibilities
+func (client *SchemaRegistryClient) CheckCompatibility(subject string, version int, schema Schema) (CompatibilityResult, error) {
+	result := CompatibilityResult{}
+	err := client.Post(&result, withFlag(APIPathCompatibility.Format(*client, subject, "versions", versionID(version)), "verbose", true), schema.request())
+	return result, err
+}
+
+//GetGlobalCompatibility returns the default compatibility level of all subjects
+func (client *SchemaRegistryClient) GetGlobalCompatibility() (string, error) {
+	config := compatibilityConfig{}
+	err := client.Get(&config, APIPathConfig.Format(*client))
+	return config.level(), err
+}
+
+//SetGlobalCompatibility changes the default compatibility level of all subjects
+func (client *SchemaRegistryClient) SetGlobalCompatibility(level string) error {
+	return client.Put(nil, APIPathConfig.Format(*client), compatibilityConfig{Compatibility: level})
+}
+
+//GetSubjectCompatibility returns the compatibility level of a subject. Subjects without their own level return an error, unless defaultToGlobal is true
+func (client *SchemaRegistryClient) GetSubjectCompatibility(subject string, defaultToGlobal bool) (string, error) {
+	config := compatibilityConfig{}
+	err := client.Get(&config, withFlag(APIPathConfig.Format(*client, subject), "defaultToGlobal", defaultToGlobal))
+	return config.level(), err
+}
+
+//SetSubjectCompatibility changes the compatibility level of a subject
+func (client *SchemaRegistryClient) SetSubjectCompatibility(subject string, level string) error {
+	return client.Put(nil, APIPathConfig.Format(*client, subject), compatibilityConfig{Compatibility: level})
+}
+
+//DeleteSubjectCompatibility removes the compatibility level of a subject, reverting it to the global level
+func (client *SchemaRegistryClient) DeleteSubjectCompatibility(subject string) error {
+	return client.Delete(nil, APIPathConfig.Format(*client, subject))
+}
@@ -0,0 +1,36 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	//ErrCodeSubjectNotFound is the error code returned when a subject doesn't exist
+	ErrCodeSubjectNotFound int = 40401
+	//ErrCodeVersionNotFound is the error code returned when a version of a subject doesn't exist
+	ErrCodeVersionNotFound int = 40402
+	//ErrCodeSchemaNotFound is the error code returned when a schema doesn't exist
+	ErrCodeSchemaNotFound int = 40403
+	//ErrCodeIncompatibleSchema is the error code returned when registering a schema that breaks the subject's compatibility
+	ErrCodeIncompatibleSchema int = 409
+	//ErrCodeInvalidSchema is the error code returned when a schema can't be parsed
+	ErrCodeInvalidSchema int = 42201
+)
+
+//ErrorResponse is the JSON error body returned by the Schema Registry API
+type ErrorResponse struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+func (err ErrorResponse) Error() string {
+	return fmt.Sprintf("SchemaRegistryError(%d - %s)", err.ErrorCode, err.Message)
+}
+
+//ErrorResponseHandler is a HTTPErrorHandler that decodes the Schema Registry JSON error body
+func ErrorResponseHandler(statusCode int, body []byte) error {
+	err := ErrorResponse{ErrorCode: statusCode}
+	json.Unmarshal(body, &err)
+	return err
+}
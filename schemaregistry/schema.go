@@ -0,0 +1,131 @@
+package schemaregistry
+
+import (
+	"strconv"
+)
+
+const (
+	//SchemaTypeAvro is the type of Avro schemas, and the default when no type is given
+	SchemaTypeAvro string = "AVRO"
+	//SchemaTypeProtobuf is the type of Protobuf schemas
+	SchemaTypeProtobuf string = "PROTOBUF"
+	//SchemaTypeJSON is the type of JSON Schema schemas
+	SchemaTypeJSON string = "JSON"
+
+	//LatestVersion refers to the latest version of a subject
+	LatestVersion int = -1
+)
+
+//Schema is a schema registered under a subject
+type Schema struct {
+	Subject    string            `json:"subject,omitempty"`
+	ID         int               `json:"id,omitempty"`
+	Version    int               `json:"version,omitempty"`
+	SchemaType string            `json:"schemaType,omitempty"`
+	Schema     string            `json:"schema"`
+	References []SchemaReference `json:"references,omitempty"`
+}
+
+//SchemaReference is a reference from a schema to another registered schema, such as an imported Protobuf file
+type SchemaReference struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
+
+//registeredSchema is the response from registering a schema
+type registeredSchema struct {
+	ID int `json:"id"`
+}
+
+//Avro creates a new Avro Schema
+func Avro(schema string, references ...SchemaReference) Schema {
+	return Schema{SchemaType: SchemaTypeAvro, Schema: schema, References: references}
+}
+
+//Protobuf creates a new Protobuf Schema
+func Protobuf(schema string, references ...SchemaReference) Schema {
+	return Schema{SchemaType: SchemaTypeProtobuf, Schema: schema, References: references}
+}
+
+//JSON creates a new JSON Schema
+func JSON(schema string, references ...SchemaReference) Schema {
+	return Schema{SchemaType: SchemaTypeJSON, Schema: schema, References: references}
+}
+
+//Type returns the type of the schema, defaulting to Avro as the API does
+func (s Schema) Type() string {
+	if s.SchemaType == "" {
+		return SchemaTypeAvro
+	}
+	return s.SchemaType
+}
+
+//request returns only the fields of the schema that are sent when registering, looking up or checking compatibility
+func (s Schema) request() Schema {
+	return Schema{SchemaType: s.SchemaType, Schema: s.Schema, References: s.References}
+}
+
+//ListSubjects returns all the subjects. Soft deleted subjects are included when deleted is true
+func (client *SchemaRegistryClient) ListSubjects(deleted bool) ([]string, error) {
+	subjects := []string{}
+	err := client.Get(&subjects, withFlag(APIPathSubjects.Format(*client), "deleted", deleted))
+	return subjects, err
+}
+
+//DeleteSubject deletes a subject, returning the versions deleted. A subject must be soft deleted before it can be permanently deleted
+func (client *SchemaRegistryClient) DeleteSubject(subject string, permanent bool) ([]int, error) {
+	versions := []int{}
+	err := client.Delete(&versions, withFlag(APIPathSubjects.Format(*client, subject), "permanent", permanent))
+	return versions, err
+}
+
+//ListVersions returns the versions registered under a subject
+func (client *SchemaRegistryClient) ListVersions(subject string) ([]int, error) {
+	versions := []int{}
+	err := client.Get(&versions, APIPathSubjects.Format(*client, subject, "versions"))
+	return versions, err
+}
+
+//GetSchema returns a single version of a subject. Use LatestVersion for the latest
+func (client *SchemaRegistryClient) GetSchema(subject string, version int) (Schema, error) {
+	schema := Schema{}
+	err := client.Get(&schema, APIPathSubjects.Format(*client, subject, "versions", versionID(version)))
+	return schema, err
+}
+
+//GetLatestSchema returns the latest version of a subject
+func (client *SchemaRegistryClient) GetLatestSchema(subject string) (Schema, error) {
+	return client.GetSchema(subject, LatestVersion)
+}
+
+//DeleteSchemaVersion deletes a single version of a subject, returning the version deleted
+func (client *SchemaRegistryClient) DeleteSchemaVersion(subject string, version int, permanent bool) (int, error) {
+	deleted := 0
+	err := client.Delete(&deleted, withFlag(APIPathSubjects.Format(*client, subject, "versions", versionID(version)), "permanent", permanent))
+	return deleted, err
+}
+
+//RegisterSchema registers a schema under a subject, returning its globally unique ID. Registering an already registered schema returns its existing ID
+func (client *SchemaRegistryClient) RegisterSchema(subject string, schema Schema) (int, error) {
+	registered := registeredSchema{}
+	err := client.Post(&registered, APIPathSubjects.Format(*client, subject, "versions"), schema.request())
+	return registered.ID, err
+}
+
+//LookupSchema checks if a schema is registered under a subject, returning it with its ID and version
+func (client *SchemaRegistryClient) LookupSchema(subject string, schema Schema) (Schema, error) {
+	found := Schema{}
+	err := client.Post(&found, APIPathSubjects.Format(*client, subject), schema.request())
+	return found, err
+}
+
+//GetSchemaByID returns a schema by its globally unique ID, such as the one embedded within serialized records
+func (client *SchemaRegistryClient) GetSchemaByID(id int) (Schema, error) {
+	schema := Schema{}
+	err := client.Get(&schema, APIPathSchemaIDs.Format(*client, strconv.Itoa(id)))
+	if err == nil {
+		schema.ID = id
+	}
+	return schema, err
+}
@@ -0,0 +1,170 @@
+package schemaregistry
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/stretchr/testify/assert"
+)
+
+const orderSchema = `{"type":"record","name":"Order","fields":[{"name":"id","type":"string"}]}`
+
+func newTestClient(handler http.HandlerFunc) (*httptest.Server, *SchemaRegistryClient) {
+	srv := httptest.NewServer(handler)
+	c := New(srv.URL+"/", "sr-key", "sr-secret")
+	return srv, &c
+}
+
+func TestSubjects(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, _ := r.BasicAuth()
+		assert.Equal("sr-key", user, "The Schema Registry API Key should be used")
+		assert.Equal("sr-secret", pass)
+		assert.Equal(ContentType, r.Header.Get("Accept"))
+
+		body, _ := ioutil.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.RequestURI() {
+		case "GET /subjects":
+			w.Write([]byte(`["orders-value","payments-value"]`))
+		case "GET /subjects?deleted=true":
+			w.Write([]byte(`["orders-value","payments-value","old-value"]`))
+		case "GET /subjects/orders-value/versions":
+			w.Write([]byte(`[1,2]`))
+		case "GET /subjects/orders-value/versions/latest":
+			w.Write([]byte(`{"subject":"orders-value","id":12,"version":2,"schema":"{\"type\":\"string\"}"}`))
+		case "POST /subjects/orders-value/versions":
+			assert.JSONEq(`{"schemaType":"PROTOBUF","schema":"syntax = \"proto3\";","references":[{"name":"common.proto","subject":"common","version":1}]}`, string(body))
+			w.Write([]byte(`{"id":13}`))
+		case "POST /subjects/orders-value":
+			w.Write([]byte(`{"subject":"orders-value","id":12,"version":2,"schema":"{\"type\":\"string\"}"}`))
+		case "GET /schemas/ids/12":
+			w.Write([]byte(`{"schemaType":"JSON","schema":"{}"}`))
+		case "DELETE /subjects/orders-value/versions/1?permanent=true":
+			w.Write([]byte(`1`))
+		case "DELETE /subjects/payments-value":
+			w.Write([]byte(`[1,2,3]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_code":40401,"message":"Subject 'missing' not found."}`))
+		}
+	})
+	defer srv.Close()
+
+	subjects, err := c.ListSubjects(false)
+	assert.NoError(err)
+	assert.Equal([]string{"orders-value", "payments-value"}, subjects)
+	subjects, err = c.ListSubjects(true)
+	assert.NoError(err)
+	assert.Len(subjects, 3, "Soft deleted subjects should be included")
+
+	versions, err := c.ListVersions("orders-value")
+	assert.NoError(err)
+	assert.Equal([]int{1, 2}, versions)
+
+	schema, err := c.GetLatestSchema("orders-value")
+	assert.NoError(err)
+	assert.Equal(12, schema.ID)
+	assert.Equal(SchemaTypeAvro, schema.Type(), "Schemas without a type should be Avro")
+
+	id, err := c.RegisterSchema("orders-value", Protobuf(`syntax = "proto3";`, SchemaReference{Name: "common.proto", Subject: "common", Version: 1}))
+	assert.NoError(err)
+	assert.Equal(13, id)
+
+	schema, err = c.LookupSchema("orders-value", Avro(`"string"`))
+	assert.NoError(err)
+	assert.Equal(2, schema.Version)
+
+	schema, err = c.GetSchemaByID(12)
+	assert.NoError(err)
+	assert.Equal(12, schema.ID)
+	assert.Equal(SchemaTypeJSON, schema.Type())
+
+	deleted, err := c.DeleteSchemaVersion("orders-value", 1, true)
+	assert.NoError(err)
+	assert.Equal(1, deleted)
+
+	versions, err = c.DeleteSubject("payments-value", false)
+	assert.NoError(err)
+	assert.Equal([]int{1, 2, 3}, versions)
+
+	_, err = c.GetLatestSchema("missing")
+	assert.Error(err)
+	assert.Equal(ErrCodeSubjectNotFound, err.(client.Error).Unwrap().(ErrorResponse).ErrorCode, "Schema Registry error codes should be decoded")
+}
+
+func TestCompatibility(t *testing.T) {
+	assert := assert.New(t)
+
+	srv, c := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		switch r.Method + " " + r.URL.RequestURI() {
+		case "POST /compatibility/subjects/orders-value/versions/latest?verbose=true":
+			assert.JSONEq(`{"schemaType":"AVRO","schema":`+quote(orderSchema)+`}`, string(body))
+			w.Write([]byte(`{"is_compatible":false,"messages":["Missing default for field id"]}`))
+		case "GET /config":
+			w.Write([]byte(`{"compatibilityLevel":"BACKWARD"}`))
+		case "PUT /config":
+			assert.JSONEq(`{"compatibility":"FULL"}`, string(body))
+			w.Write(body)
+		case "GET /config/orders-value?defaultToGlobal=true":
+			w.Write([]byte(`{"compatibilityLevel":"FULL"}`))
+		case "PUT /config/orders-value":
+			assert.JSONEq(`{"compatibility":"NONE"}`, string(body))
+			w.Write(body)
+		case "DELETE /config/orders-value":
+			w.Write([]byte(`{"compatibilityLevel":"NONE"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer srv.Close()
+
+	result, err := c.CheckCompatibility("orders-value", LatestVersion, Avro(orderSchema))
+	assert.NoError(err)
+	assert.False(result.IsCompatible)
+	assert.Len(result.Messages, 1)
+
+	level, err := c.GetGlobalCompatibility()
+	assert.NoError(err)
+	assert.Equal(CompatibilityBackward, level)
+	assert.NoError(c.SetGlobalCompatibility(CompatibilityFull))
+
+	level, err = c.GetSubjectCompatibility("orders-value", true)
+	assert.NoError(err)
+	assert.Equal(CompatibilityFull, level)
+	assert.NoError(c.SetSubjectCompatibility("orders-value", CompatibilityNone))
+	assert.NoError(c.DeleteSubjectCompatibility("orders-value"))
+}
+
+func quote(s string) string {
+	js, _ := json.Marshal(s)
+	return string(js)
+}
+
+func TestNewInRegion(t *testing.T) {
+	assert := assert.New(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/subjects", r.URL.Path)
+		w.Write([]byte(`["orders-value"]`))
+	}))
+	defer srv.Close()
+	endpoints := client.NewEndpoints().SetRegion(client.ServiceSchemaRegistry, "AWS", "us-east-2", srv.URL)
+
+	c := NewInRegion("https://psrc-public.us-east-2.aws.confluent.cloud", "AWS", "us-east-2", "sr-key", "sr-secret")
+	c.Context.Resolver = endpoints
+	subjects, err := c.ListSubjects(false)
+	assert.NoError(err, "The region override should be used in place of the endpoint")
+	assert.Equal([]string{"orders-value"}, subjects)
+
+	c = NewInRegion(srv.URL, "GCP", "us-central1", "sr-key", "sr-secret")
+	c.Context.Resolver = client.NewEndpoints().SetRegion(client.ServiceSchemaRegistry, "AWS", "us-east-2", "http://127.0.0.1:1")
+	_, err = c.ListSubjects(false)
+	assert.NoError(err, "Other regions should keep their endpoint")
+}
@@ -0,0 +1,242 @@
+package alerting
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/logging"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+	"go.uber.org/zap"
+)
+
+const (
+	//DefaultEvaluationInterval is the default time between evaluations of all the rules
+	DefaultEvaluationInterval time.Duration = time.Minute
+)
+
+//Evaluator runs alert rules on a schedule, tracking the pending/firing/resolved state of every series of every rule
+type Evaluator struct {
+	*logging.Loggable
+	Client   telemetry.PagedMetricQuerier
+	Notifier Notifier
+	Rules    []Rule
+	Interval time.Duration
+
+	mu     sync.Mutex
+	alerts map[string]*Alert
+}
+
+//NewEvaluator creates a new Evaluator for the given rules
+func NewEvaluator(client telemetry.PagedMetricQuerier, notifier Notifier, rules ...Rule) *Evaluator {
+	return &Evaluator{
+		Loggable: logging.New("AlertEvaluator"),
+		Client:   client,
+		Notifier: notifier,
+		Rules:    rules,
+		Interval: DefaultEvaluationInterval,
+		alerts:   map[string]*Alert{},
+	}
+}
+
+//Run evaluates all the rules every Interval until the context is cancelled
+func (e *Evaluator) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := e.Evaluate(time.Now()); err != nil {
+			e.Log.Error("Alerts - Evaluation failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+//Evaluate evaluates all the rules once, as of the given time, sending notifications for any state changes
+func (e *Evaluator) Evaluate(now time.Time) error {
+	failed := []string{}
+	for _, rule := range e.Rules {
+		if err := e.EvaluateRule(rule, now); err != nil {
+			e.Log.Error("Alerts - Rule evaluation failed",
+				zap.String("rule", rule.Name),
+				zap.Error(err),
+			)
+			failed = append(failed, fmt.Sprintf("%s: %s", rule.Name, err))
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("%d rule(s) failed to evaluate [`%s`]", len(failed), strings.Join(failed, "`,`"))
+	}
+	return nil
+}
+
+//EvaluateRule evaluates a single rule, as of the given time, sending notifications for any state changes.
+//Notifications are sent once the state has been updated, so a slow Notifier doesn't hold up Alerts or the other rules.
+func (e *Evaluator) EvaluateRule(rule Rule, now time.Time) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	res, err := telemetry.QueryAllPages(e.Client, rule.BuildQuery(now))
+	if err != nil {
+		return err
+	}
+
+	values := map[string]float64{}
+	series := map[string]map[string]string{}
+	for key, points := range seriesOf(res.Data) {
+		vals := make([]float64, len(points))
+		for i, p := range points {
+			vals[i] = p.Value
+		}
+		v, err := rule.Reduce.Apply(vals)
+		if err != nil {
+			return err
+		}
+		values[key] = v
+		series[key] = seriesLabels(points[0])
+	}
+
+	for _, alert := range e.transition(rule, values, series, now) {
+		if e.Notifier == nil {
+			continue
+		}
+		if err := e.Notifier.Notify(alert); err != nil {
+			e.Log.Error("Alerts - Notification failed",
+				zap.String("rule", alert.Rule),
+				zap.String("state", string(alert.State)),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+//transition updates the state of every series of the rule, returning the alerts that need to be notified
+func (e *Evaluator) transition(rule Rule, values map[string]float64, series map[string]map[string]string, now time.Time) []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	notify := []Alert{}
+	for key, value := range values {
+		breached, _ := rule.Comparison.Compare(value, rule.Threshold)
+		id := rule.Name + "\x00" + key
+		alert, active := e.alerts[id]
+
+		if !breached {
+			if active && alert.State == StateFiring {
+				alert.State = StateResolved
+				alert.Value = value
+				alert.ResolvedAt = now
+				notify = append(notify, *alert)
+			}
+			delete(e.alerts, id)
+			continue
+		}
+
+		if !active {
+			alert = &Alert{
+				Rule:       rule.Name,
+				State:      StatePending,
+				Series:     series[key],
+				Labels:     rule.Labels,
+				Threshold:  rule.Threshold,
+				Comparison: rule.Comparison,
+				ActiveAt:   now,
+			}
+			e.alerts[id] = alert
+		}
+		alert.Value = value
+
+		if alert.State == StatePending && now.Sub(alert.ActiveAt) >= rule.For {
+			alert.State = StateFiring
+			alert.FiredAt = now
+			notify = append(notify, *alert)
+		}
+	}
+
+	//Series that have stopped reporting can no longer be breaching
+	prefix := rule.Name + "\x00"
+	for id, alert := range e.alerts {
+		if !strings.HasPrefix(id, prefix) {
+			continue
+		}
+		if _, ok := values[strings.TrimPrefix(id, prefix)]; ok {
+			continue
+		}
+		if alert.State == StateFiring {
+			alert.State = StateResolved
+			alert.ResolvedAt = now
+			notify = append(notify, *alert)
+		}
+		delete(e.alerts, id)
+	}
+
+	return notify
+}
+
+//Alerts returns all the currently pending and firing alerts
+func (e *Evaluator) Alerts() []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	alerts := make([]Alert, 0, len(e.alerts))
+	for _, a := range e.alerts {
+		alerts = append(alerts, *a)
+	}
+	sort.Slice(alerts, func(i, j int) bool {
+		if alerts[i].Rule != alerts[j].Rule {
+			return alerts[i].Rule < alerts[j].Rule
+		}
+		return seriesKey(alerts[i].Series) < seriesKey(alerts[j].Series)
+	})
+	return alerts
+}
+
+//seriesOf splits the data points into series, by their label values, sorted by timestamp
+func seriesOf(data []response.Telemetry) map[string][]response.Telemetry {
+	series := map[string][]response.Telemetry{}
+	for _, d := range data {
+		key := seriesKey(seriesLabels(d))
+		series[key] = append(series[key], d)
+	}
+	for _, points := range series {
+		sort.Slice(points, func(i, j int) bool {
+			return points[i].Timestamp.Before(points[j].Timestamp)
+		})
+	}
+	return series
+}
+
+func seriesLabels(d response.Telemetry) map[string]string {
+	lbls := make(map[string]string, len(d.Fields))
+	for k, v := range d.Fields {
+		lbls[k] = response.LabelValue{Key: k, Raw: v}.String()
+	}
+	return lbls
+}
+
+func seriesKey(lbls map[string]string) string {
+	keys := make([]string, 0, len(lbls))
+	for k := range lbls {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + "=" + lbls[k]
+	}
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,208 @@
+package alerting
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeQuerier struct {
+	data []response.Telemetry
+}
+
+func (f *fakeQuerier) PostMetricsQuery(q query.Query) (response.Query, error) {
+	return f.PostMetricsQueryPage(q, "")
+}
+
+func (f *fakeQuerier) PostMetricsQueryPage(q query.Query, pageToken string) (response.Query, error) {
+	return response.Query{Data: f.data}, nil
+}
+
+func point(value float64) response.Telemetry {
+	return response.Telemetry{
+		Value:  value,
+		Fields: map[string]interface{}{labels.Key(labels.ResourceKafka): "lkc-1"},
+	}
+}
+
+func TestReduction_Apply(t *testing.T) {
+	assert := assert.New(t)
+
+	values := []float64{3, 9, 1, 7}
+	for reduction, expected := range map[Reduction]float64{ReduceLast: 7, ReduceMax: 9, ReduceMin: 1, ReduceSum: 20, ReduceAvg: 5} {
+		v, err := reduction.Apply(values)
+		assert.Nil(err)
+		assert.Equal(expected, v, "Reduction %s doesn't match expected", reduction)
+	}
+
+	_, err := ReduceLast.Apply(nil)
+	assert.NotNil(err, "Empty series should fail to reduce")
+}
+
+func TestEvaluator_Lifecycle(t *testing.T) {
+	assert := assert.New(t)
+
+	client := &fakeQuerier{}
+	notified := []Alert{}
+	notifier := NotifierFunc(func(alert Alert) error {
+		notified = append(notified, alert)
+		return nil
+	})
+
+	rule := Rule{
+		Name:       "HighIngress",
+		Metric:     metric.KafkaServerReceivedBytes,
+		Resource:   labels.ResourceKafka,
+		ResourceID: "lkc-1",
+		Reduce:     ReduceMax,
+		Comparison: CompareGreaterThan,
+		Threshold:  100,
+		For:        2 * time.Minute,
+		Labels:     map[string]string{"severity": "page"},
+	}
+	evaluator := NewEvaluator(client, notifier, rule)
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	client.data = []response.Telemetry{point(150)}
+	assert.Nil(evaluator.Evaluate(now))
+	assert.Len(notified, 0, "Pending alerts shouldn't notify")
+	assert.Len(evaluator.Alerts(), 1)
+	assert.Equal(StatePending, evaluator.Alerts()[0].State)
+
+	assert.Nil(evaluator.Evaluate(now.Add(2 * time.Minute)))
+	assert.Len(notified, 1, "Alert should have fired")
+	assert.Equal(StateFiring, notified[0].State)
+	assert.Equal("lkc-1", notified[0].Series["resource.kafka.id"])
+	assert.Equal("page", notified[0].Labels["severity"])
+
+	assert.Nil(evaluator.Evaluate(now.Add(3 * time.Minute)))
+	assert.Len(notified, 1, "Firing alerts should only notify once")
+
+	client.data = []response.Telemetry{point(50)}
+	assert.Nil(evaluator.Evaluate(now.Add(4 * time.Minute)))
+	assert.Len(notified, 2, "Alert should have resolved")
+	assert.Equal(StateResolved, notified[1].State)
+	assert.Len(evaluator.Alerts(), 0)
+
+	client.data = []response.Telemetry{point(150)}
+	assert.Nil(evaluator.Evaluate(now.Add(5 * time.Minute)))
+	client.data = nil
+	assert.Nil(evaluator.Evaluate(now.Add(6 * time.Minute)))
+	assert.Len(notified, 2, "Pending alerts that stop reporting shouldn't notify")
+	assert.Len(evaluator.Alerts(), 0)
+}
+
+func TestEvaluator_NotifyOutsideLock(t *testing.T) {
+	assert := assert.New(t)
+
+	client := &fakeQuerier{data: []response.Telemetry{point(150)}}
+	var evaluator *Evaluator
+	seen := []Alert{}
+	notifier := NotifierFunc(func(alert Alert) error {
+		seen = evaluator.Alerts()
+		return nil
+	})
+	evaluator = NewEvaluator(client, notifier, Rule{
+		Name:       "HighIngress",
+		Metric:     metric.KafkaServerReceivedBytes,
+		Resource:   labels.ResourceKafka,
+		ResourceID: "lkc-1",
+		Comparison: CompareGreaterThan,
+		Threshold:  100,
+	})
+
+	done := make(chan error)
+	go func() { done <- evaluator.Evaluate(time.Now()) }()
+	select {
+	case err := <-done:
+		assert.NoError(err)
+		assert.Len(seen, 1, "Notifiers should be able to read the alerts")
+	case <-time.After(5 * time.Second):
+		assert.Fail("Notifying while holding the lock deadlocked")
+	}
+}
+
+func TestRule_BuildQuery(t *testing.T) {
+	assert := assert.New(t)
+
+	rule := Rule{
+		Name:        "HighIngress",
+		Metric:      metric.KafkaServerReceivedBytes,
+		Resource:    labels.ResourceKafka,
+		ResourceID:  "lkc-1",
+		Granularity: granularity.FiveMin,
+	}
+	now := time.Date(2021, 1, 1, 0, 7, 30, 0, time.UTC)
+
+	q := rule.BuildQuery(now)
+	assert.Equal(DefaultPageLimit, q.Limit)
+	assert.Equal(granularity.FiveMin, q.Granularity)
+	assert.Equal(time.Date(2021, 1, 1, 0, 5, 0, 0, time.UTC), q.Intervals[0].End(), "The window should end at the last closed bucket")
+	assert.Equal(DefaultWindow, q.Intervals[0].Duration())
+
+	rule.Granularity = granularity.Granularity{}
+	q = rule.BuildQuery(now)
+	assert.Equal(granularity.OneMin, q.Granularity)
+	assert.Equal(time.Date(2021, 1, 1, 0, 7, 0, 0, time.UTC), q.Intervals[0].End())
+}
+
+func TestEvaluator_InvalidRule(t *testing.T) {
+	assert := assert.New(t)
+
+	evaluator := NewEvaluator(&fakeQuerier{}, nil, Rule{Name: "NoMetric", Comparison: CompareGreaterThan})
+	assert.NotNil(evaluator.Evaluate(time.Now()))
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	assert := assert.New(t)
+
+	received := Alert{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookNotifier(server.URL)
+	assert.Nil(notifier.Notify(Alert{Rule: "HighIngress", State: StateFiring, Value: 150}))
+	assert.Equal("HighIngress", received.Rule)
+	assert.Equal(StateFiring, received.State)
+
+	failing := NewWebhookNotifier(server.URL + "/broken")
+	assert.NotNil(failing.Notify(Alert{}), "None 2xx responses should fail")
+}
+
+func TestAlert_MarshalJSON(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2021, 4, 20, 16, 15, 0, 0, time.UTC)
+
+	js, err := json.Marshal(Alert{Rule: "HighIngress", State: StatePending, ActiveAt: now})
+	assert.NoError(err)
+	assert.Contains(string(js), `"active_at":"2021-04-20T16:15:00Z"`)
+	assert.NotContains(string(js), "fired_at", "Alerts that haven't fired should leave out fired_at")
+	assert.NotContains(string(js), "resolved_at", "Alerts that haven't resolved should leave out resolved_at")
+
+	alert := Alert{Rule: "HighIngress", State: StateResolved, ActiveAt: now, FiredAt: now.Add(time.Minute), ResolvedAt: now.Add(2 * time.Minute)}
+	js, err = json.Marshal(alert)
+	assert.NoError(err)
+	assert.Contains(string(js), `"fired_at":"2021-04-20T16:16:00Z"`)
+	assert.Contains(string(js), `"resolved_at":"2021-04-20T16:17:00Z"`)
+
+	decoded := Alert{}
+	assert.NoError(json.Unmarshal(js, &decoded))
+	assert.Equal(alert.Rule, decoded.Rule)
+	assert.True(alert.ResolvedAt.Equal(decoded.ResolvedAt), "Alerts should round trip")
+}
@@ -0,0 +1,113 @@
+package alerting
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const (
+	//StatePending is a static def for a series breaching its threshold, but not yet for long enough to fire
+	StatePending State = "pending"
+	//StateFiring is a static def for a series that has breached its threshold for the rule's For duration
+	StateFiring State = "firing"
+	//StateResolved is a static def for a previously firing series that no longer breaches its threshold
+	StateResolved State = "resolved"
+
+	//DefaultWebhookTimeout is the default time to wait on a webhook before considering it failed
+	DefaultWebhookTimeout time.Duration = time.Second * 10
+)
+
+//State represents the state of an alert for a single series
+type State string
+
+//Alert is the state of a single rule for a single series
+type Alert struct {
+	Rule       string            `json:"rule"`
+	State      State             `json:"state"`
+	Series     map[string]string `json:"series"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	Value      float64           `json:"value"`
+	Threshold  float64           `json:"threshold"`
+	Comparison Comparison        `json:"comparison"`
+	ActiveAt   time.Time         `json:"active_at"`
+	FiredAt    time.Time         `json:"fired_at"`
+	ResolvedAt time.Time         `json:"resolved_at"`
+}
+
+//MarshalJSON leaves out FiredAt and ResolvedAt until the alert has fired or been resolved, as omitempty has no effect on a time.Time
+func (a Alert) MarshalJSON() ([]byte, error) {
+	type alert Alert
+	js := struct {
+		alert
+		FiredAt    *time.Time `json:"fired_at,omitempty"`
+		ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	}{alert: alert(a)}
+	if !a.FiredAt.IsZero() {
+		js.FiredAt = &a.FiredAt
+	}
+	if !a.ResolvedAt.IsZero() {
+		js.ResolvedAt = &a.ResolvedAt
+	}
+	return json.Marshal(js)
+}
+
+//Notifier is notified whenever an alert starts firing or is resolved
+type Notifier interface {
+	Notify(alert Alert) error
+}
+
+//NotifierFunc allows a simple func to be used as a Notifier
+type NotifierFunc func(alert Alert) error
+
+func (f NotifierFunc) Notify(alert Alert) error {
+	return f(alert)
+}
+
+//WebhookNotifier POSTs each Alert, as JSON, to a given URL
+type WebhookNotifier struct {
+	URL         string
+	HTTPHeaders map[string]string
+	httpClient  http.Client
+}
+
+//NewWebhookNotifier creates a new WebhookNotifier for the given URL
+func NewWebhookNotifier(url string) *WebhookNotifier {
+	return &WebhookNotifier{
+		URL: url,
+		httpClient: http.Client{
+			Timeout: DefaultWebhookTimeout,
+		},
+	}
+}
+
+func (w *WebhookNotifier) Notify(alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", w.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	for header, value := range w.HTTPHeaders {
+		req.Header.Add(header, value)
+	}
+
+	res, err := w.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		resBody, _ := ioutil.ReadAll(res.Body)
+		return fmt.Errorf("Webhook %s returned status code (%d): %s", w.URL, res.StatusCode, string(resBody))
+	}
+	return nil
+}
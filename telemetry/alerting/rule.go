@@ -0,0 +1,194 @@
+package alerting
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/group"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+)
+
+const (
+	//ReduceLast is a static def for reducing a series to its latest value
+	ReduceLast Reduction = "last"
+	//ReduceAvg is a static def for reducing a series to the average of its values
+	ReduceAvg Reduction = "avg"
+	//ReduceMax is a static def for reducing a series to its max value
+	ReduceMax Reduction = "max"
+	//ReduceMin is a static def for reducing a series to its min value
+	ReduceMin Reduction = "min"
+	//ReduceSum is a static def for reducing a series to the sum of its values
+	ReduceSum Reduction = "sum"
+
+	//CompareGreaterThan is a static def for the > comparison
+	CompareGreaterThan Comparison = ">"
+	//CompareGreaterThanOrEqualTo is a static def for the >= comparison
+	CompareGreaterThanOrEqualTo Comparison = ">="
+	//CompareLessThan is a static def for the < comparison
+	CompareLessThan Comparison = "<"
+	//CompareLessThanOrEqualTo is a static def for the <= comparison
+	CompareLessThanOrEqualTo Comparison = "<="
+	//CompareEqualTo is a static def for the == comparison
+	CompareEqualTo Comparison = "=="
+	//CompareNotEqualTo is a static def for the != comparison
+	CompareNotEqualTo Comparison = "!="
+
+	//DefaultWindow is the default window of time a rule's query looks back over
+	DefaultWindow time.Duration = 15 * time.Minute
+	//DefaultPageLimit is the query limit used for rules that don't set one. Every page is fetched regardless
+	DefaultPageLimit int = telemetry.DefaultQueryLimit
+)
+
+//Reduction reduces all the values of a series within the rule's window into a single value
+type Reduction string
+
+//Apply reduces the values, in timestamp order, into a single value
+func (r Reduction) Apply(values []float64) (float64, error) {
+	if len(values) <= 0 {
+		return 0, errors.New("Can not reduce an empty series")
+	}
+
+	switch r {
+	case ReduceLast, "":
+		return values[len(values)-1], nil
+	case ReduceMax, ReduceMin:
+		v := values[0]
+		for _, val := range values[1:] {
+			if (r == ReduceMax && val > v) || (r == ReduceMin && val < v) {
+				v = val
+			}
+		}
+		return v, nil
+	case ReduceSum, ReduceAvg:
+		sum := 0.0
+		for _, val := range values {
+			sum += val
+		}
+		if r == ReduceAvg {
+			return sum / float64(len(values)), nil
+		}
+		return sum, nil
+	default:
+		return 0, fmt.Errorf("Unknown reduction `%s`", r)
+	}
+}
+
+//Comparison compares a reduced value against a rule's threshold
+type Comparison string
+
+//Compare checks if the value breaches the threshold
+func (c Comparison) Compare(value float64, threshold float64) (bool, error) {
+	switch c {
+	case CompareGreaterThan:
+		return value > threshold, nil
+	case CompareGreaterThanOrEqualTo:
+		return value >= threshold, nil
+	case CompareLessThan:
+		return value < threshold, nil
+	case CompareLessThanOrEqualTo:
+		return value <= threshold, nil
+	case CompareEqualTo:
+		return value == threshold, nil
+	case CompareNotEqualTo:
+		return value != threshold, nil
+	default:
+		return false, fmt.Errorf("Unknown comparison `%s`", c)
+	}
+}
+
+//Rule is a threshold based alert rule over a telemetry query.
+//Either a full Query can be given, in which case its Intervals are replaced with the rule's Window,
+//or a Metric, Resource, ResourceID, and optional Filters and GroupBy labels the query will be built from.
+type Rule struct {
+	Name        string
+	Query       *query.Query
+	Metric      metric.Metric
+	Resource    labels.Resource
+	ResourceID  string
+	Filters     []filter.Filter
+	GroupBy     []labels.Label
+	Window      time.Duration
+	Granularity granularity.Granularity
+	Reduce      Reduction
+	Comparison  Comparison
+	Threshold   float64
+	For         time.Duration
+	Labels      map[string]string
+}
+
+//Validate checks that the rule has everything needed to be evaluated
+func (r Rule) Validate() error {
+	if r.Name == "" {
+		return errors.New("Rules require a Name")
+	}
+	if r.Query == nil && r.Metric.Name == "" {
+		return fmt.Errorf("Rule `%s` requires either a Query or a Metric", r.Name)
+	}
+	if r.Query == nil && r.ResourceID == "" {
+		return fmt.Errorf("Rule `%s` requires a ResourceID when no Query is given", r.Name)
+	}
+	if _, err := r.Comparison.Compare(0, 0); err != nil {
+		return err
+	}
+	if _, err := r.Reduce.Apply([]float64{0}); err != nil {
+		return err
+	}
+	return nil
+}
+
+//BuildQuery builds the Metric Query for the rule's window of time.
+//The window ends at the start of the bucket the given time falls within, as the latest bucket is still incomplete and would read low for delta metrics.
+func (r Rule) BuildQuery(now time.Time) query.Query {
+	window := r.Window
+	if window <= 0 {
+		window = DefaultWindow
+	}
+
+	if r.Query != nil {
+		q := *r.Query
+		inter, gran := closedWindow(window, now, q.Granularity)
+		q.Intervals = interval.Of(inter)
+		q.Granularity = gran
+		if q.Limit <= 0 {
+			q.Limit = DefaultPageLimit
+		}
+		return q
+	}
+
+	inter, gran := closedWindow(window, now, r.Granularity)
+
+	var fil filter.Filter = filter.EqualTo(r.Resource, r.ResourceID)
+	if len(r.Filters) > 0 {
+		fil = filter.EqualTo(r.Resource, r.ResourceID).And(r.Filters...)
+	}
+
+	return query.Query{
+		Filter:       fil,
+		Intervals:    interval.Of(inter),
+		Aggregations: agg.Of(agg.DefaultOf(r.Metric, r.GroupBy...)),
+		Granularity:  gran,
+		GroupBy:      group.Of(r.Resource).And(r.GroupBy...),
+		Limit:        DefaultPageLimit,
+	}
+}
+
+//closedWindow returns the window of time ending at the last closed bucket before the given time, along with the granularity of its buckets.
+//When no valid granularity is given, the finest one the window supports is used.
+func closedWindow(window time.Duration, now time.Time, gran granularity.Granularity) (interval.Interval, granularity.Granularity) {
+	if !gran.IsValid() {
+		gran = interval.EndingAt(window, now).MinGranularity()
+	}
+	end := now
+	if gran.Duration > 0 {
+		end = now.Truncate(gran.Duration)
+	}
+	return interval.EndingAt(window, end), gran
+}
@@ -19,7 +19,7 @@ type TelemetryAPIPath client.APIPath
 
 func (p TelemetryAPIPath) Format(client TelemetryClient, apiVersion int8) string {
 	return fmt.Sprintf(strings.Join([]string{
-		client.Context.BaseURL,
+		client.Context.ResolveBaseURL(),
 		"v" + fmt.Sprint(apiVersion),
 		string(p),
 	}, "/"), client.DataSet)
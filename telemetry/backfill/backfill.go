@@ -0,0 +1,281 @@
+package backfill
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/logging"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+	"go.uber.org/zap"
+)
+
+const (
+	//DefaultConcurrency is the default number of chunks fetched at the same time
+	DefaultConcurrency int = telemetry.DefaultMaxWorkers
+	//DefaultRequestsPerSecond is the default max rate of requests sent to the API
+	DefaultRequestsPerSecond float64 = 1
+	//FailuresFile is the name of the file, within the output directory, failed chunks are recorded to
+	FailuresFile string = "_failures.jsonl"
+)
+
+//Failure records a chunk that failed to be fetched or written
+type Failure struct {
+	ResourceID string    `json:"resource_id"`
+	Metric     string    `json:"metric"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	Error      string    `json:"error"`
+}
+
+//Summary is the outcome of a backfill run
+type Summary struct {
+	Chunks   int       `json:"chunks"`
+	Skipped  int       `json:"skipped"`
+	Written  int       `json:"written"`
+	Failures []Failure `json:"failures,omitempty"`
+}
+
+//Backfill fetches the chunks of a Job concurrently, under a rate limit, writing each chunk to its own date partitioned file.
+//Runs are restartable: chunks that already have a file on disk are skipped, and failed chunks are recorded to FailuresFile
+//so they are retried simply by running the same Job again. Every page of a chunk is fetched before it is written, so a file on disk is always a complete chunk.
+type Backfill struct {
+	*logging.Loggable
+	Client            telemetry.PagedMetricQuerier
+	OutputDir         string
+	Format            Format
+	Concurrency       int
+	RequestsPerSecond float64
+	PageLimit         int
+}
+
+//New creates a new Backfill writing to the given output directory
+func New(client telemetry.PagedMetricQuerier, outputDir string, format Format) *Backfill {
+	return &Backfill{
+		Loggable:          logging.New("TelemetryBackfill"),
+		Client:            client,
+		OutputDir:         outputDir,
+		Format:            format,
+		Concurrency:       DefaultConcurrency,
+		RequestsPerSecond: DefaultRequestsPerSecond,
+		PageLimit:         telemetry.DefaultQueryLimit,
+	}
+}
+
+//Run backfills all the chunks of a Job
+func (b *Backfill) Run(ctx context.Context, job Job) (Summary, error) {
+	summary := Summary{}
+	chunks, err := job.Chunks()
+	if err != nil {
+		return summary, err
+	}
+	summary.Chunks = len(chunks)
+
+	pending := []Chunk{}
+	for _, c := range chunks {
+		if _, err := os.Stat(filepath.Join(b.OutputDir, c.Path(b.Format))); err == nil {
+			summary.Skipped++
+			continue
+		}
+		pending = append(pending, c)
+	}
+
+	b.Log.Info("Backfill - Starting",
+		zap.Int("chunks", summary.Chunks),
+		zap.Int("skipped", summary.Skipped),
+	)
+
+	limiter := b.limiter(ctx)
+	chunkChan := make(chan Chunk)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	workers := b.Concurrency
+	if workers <= 0 {
+		workers = DefaultConcurrency
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range chunkChan {
+				select {
+				case <-ctx.Done():
+				case <-limiter:
+				}
+
+				err := ctx.Err()
+				if err == nil {
+					err = b.fetch(limitedQuerier{b.Client, ctx, limiter}, c)
+				}
+
+				mu.Lock()
+				if err != nil {
+					b.Log.Error("Backfill - Chunk failed",
+						zap.String("chunk", c.String()),
+						zap.Error(err),
+					)
+					summary.Failures = append(summary.Failures, Failure{
+						ResourceID: c.ResourceID,
+						Metric:     c.Metric.Name,
+						Start:      c.Start,
+						End:        c.End,
+						Error:      err.Error(),
+					})
+				} else {
+					summary.Written++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, c := range pending {
+		chunkChan <- c
+	}
+	close(chunkChan)
+	wg.Wait()
+
+	if err := b.writeFailures(summary.Failures); err != nil {
+		return summary, err
+	}
+	return summary, ctx.Err()
+}
+
+//fetch queries every page of a single chunk and writes it out, via a temp file, so only complete chunks ever exist on disk.
+//Should any page fail, the chunk fails without writing anything.
+func (b *Backfill) fetch(client telemetry.PagedMetricQuerier, c Chunk) error {
+	res, err := telemetry.QueryAllPages(client, c.Query(b.PageLimit))
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(b.OutputDir, c.Path(b.Format))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp-"+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+	err = WriteRecords(w, b.Format, c, NewRecords(c, res.Data))
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+//limitedQuerier waits on the rate limiter before fetching each page after the first, as the first page already waited for its chunk
+type limitedQuerier struct {
+	telemetry.PagedMetricQuerier
+	ctx     context.Context
+	limiter <-chan time.Time
+}
+
+func (q limitedQuerier) PostMetricsQueryPage(query query.Query, pageToken string) (response.Query, error) {
+	if pageToken != "" {
+		select {
+		case <-q.ctx.Done():
+			return response.Query{}, q.ctx.Err()
+		case <-q.limiter:
+		}
+	}
+	return q.PagedMetricQuerier.PostMetricsQueryPage(query, pageToken)
+}
+
+//limiter emits a token at the configured rate of requests per second
+func (b *Backfill) limiter(ctx context.Context) <-chan time.Time {
+	rps := b.RequestsPerSecond
+	if rps <= 0 {
+		rps = DefaultRequestsPerSecond
+	}
+
+	tokens := make(chan time.Time)
+	go func() {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / rps))
+		defer ticker.Stop()
+
+		next := time.Now()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case tokens <- next:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case next = <-ticker.C:
+			}
+		}
+	}()
+	return tokens
+}
+
+//writeFailures replaces the failures file with the failures of the latest run, removing it when there were none
+func (b *Backfill) writeFailures(failures []Failure) error {
+	path := filepath.Join(b.OutputDir, FailuresFile)
+	if len(failures) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(b.OutputDir, 0755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	enc := json.NewEncoder(f)
+	for _, failure := range failures {
+		if err := enc.Encode(failure); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//LoadFailures reads the failures recorded by the latest run into the given output directory
+func LoadFailures(outputDir string) ([]Failure, error) {
+	f, err := os.Open(filepath.Join(outputDir, FailuresFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	failures := []Failure{}
+	dec := json.NewDecoder(f)
+	for dec.More() {
+		failure := Failure{}
+		if err := dec.Decode(&failure); err != nil {
+			return failures, err
+		}
+		failures = append(failures, failure)
+	}
+	return failures, nil
+}
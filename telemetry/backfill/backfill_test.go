@@ -0,0 +1,185 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+	"github.com/stretchr/testify/assert"
+)
+
+//fakeQuerier returns a point per page, for a partition per page, failing every page from failPage onwards
+type fakeQuerier struct {
+	mu       sync.Mutex
+	queries  int
+	fail     bool
+	pages    int
+	failPage int
+}
+
+func (f *fakeQuerier) PostMetricsQuery(q query.Query) (response.Query, error) {
+	return f.PostMetricsQueryPage(q, "")
+}
+
+func (f *fakeQuerier) PostMetricsQueryPage(q query.Query, pageToken string) (response.Query, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	page, _ := strconv.Atoi(pageToken)
+	if page == 0 {
+		f.queries++
+	}
+	if f.fail || (f.failPage > 0 && page >= f.failPage) {
+		return response.Query{}, errors.New("API unavailable")
+	}
+
+	res := response.Query{BaseResponse: &response.BaseResponse{}, Data: []response.Telemetry{{
+		Timestamp: q.Intervals[0].Start(),
+		Value:     42,
+		Fields: map[string]interface{}{
+			"resource.kafka.id": "lkc-1",
+			"metric.topic":      "orders",
+			"metric.partition":  float64(page),
+		},
+	}}}
+	if page+1 < f.pages {
+		res.Meta.Pagination.NextPageToken = strconv.Itoa(page + 1)
+	}
+	return res, nil
+}
+
+func job() Job {
+	return Job{
+		Resource:    labels.ResourceKafka,
+		ResourceIDs: []string{"lkc-1"},
+		Metrics:     []metric.Metric{metric.KafkaServerReceivedBytes},
+		GroupBy:     []labels.Label{labels.MetricTopic, labels.MetricPartition},
+		Granularity: granularity.OneHour,
+		Start:       time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+		End:         time.Date(2021, 1, 3, 6, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestJob_Chunks(t *testing.T) {
+	assert := assert.New(t)
+
+	chunks, err := job().Chunks()
+	assert.Nil(err)
+	assert.Len(chunks, 3, "Expected 1 chunk per UTC day")
+	assert.Equal(time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC), chunks[0].End)
+	assert.Equal(time.Date(2021, 1, 3, 6, 0, 0, 0, time.UTC), chunks[2].End)
+
+	j := job()
+	j.Granularity = granularity.OneMin
+	chunks, err = j.Chunks()
+	assert.Nil(err)
+	assert.Len(chunks, 7, "Expected chunks to be capped at the granularity's max duration")
+	for _, c := range chunks {
+		assert.True(c.End.Sub(c.Start) <= granularity.OneMin.MaxDuration)
+	}
+
+	j.Granularity = granularity.All
+	_, err = j.Chunks()
+	assert.NotNil(err)
+}
+
+func TestBackfill_Run(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "backfill")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+
+	client := &fakeQuerier{}
+	b := New(client, dir, FormatCSV)
+	b.RequestsPerSecond = 1000
+
+	summary, err := b.Run(context.Background(), job())
+	assert.Nil(err)
+	assert.Equal(3, summary.Chunks)
+	assert.Equal(3, summary.Written)
+
+	js, err := ioutil.ReadFile(filepath.Join(dir, "received_bytes", "lkc-1", "dt=2021-01-02", "20210102T000000Z-20210103T000000Z.csv"))
+	assert.Nil(err)
+	lines := strings.Split(strings.TrimSpace(string(js)), "\n")
+	assert.Equal("timestamp,metric,resource_id,value,metric.topic,metric.partition", lines[0])
+	assert.Equal("2021-01-02T00:00:00Z,io.confluent.kafka.server/received_bytes,lkc-1,42,orders,0", lines[1])
+
+	summary, err = b.Run(context.Background(), job())
+	assert.Nil(err)
+	assert.Equal(3, summary.Skipped, "Chunks already on disk should be skipped")
+	assert.Equal(3, client.queries)
+}
+
+func TestBackfill_Failures(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "backfill")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+
+	client := &fakeQuerier{fail: true}
+	b := New(client, dir, FormatJSONL)
+	b.RequestsPerSecond = 1000
+
+	summary, err := b.Run(context.Background(), job())
+	assert.Nil(err)
+	assert.Len(summary.Failures, 3)
+
+	failures, err := LoadFailures(dir)
+	assert.Nil(err)
+	assert.Len(failures, 3)
+	assert.Equal("API unavailable", failures[0].Error)
+
+	client.fail = false
+	summary, err = b.Run(context.Background(), job())
+	assert.Nil(err)
+	assert.Equal(3, summary.Written, "Failed chunks should be retried")
+
+	failures, err = LoadFailures(dir)
+	assert.Nil(err)
+	assert.Len(failures, 0, "Failures file should be cleared once everything succeeds")
+}
+
+func TestBackfill_Pagination(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "backfill")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+
+	client := &fakeQuerier{pages: 3, failPage: 2}
+	b := New(client, dir, FormatCSV)
+	b.RequestsPerSecond = 1000
+
+	summary, err := b.Run(context.Background(), job())
+	assert.Nil(err)
+	assert.Len(summary.Failures, 3, "A failed page should fail its chunk")
+	assert.Equal(0, summary.Written)
+	path := filepath.Join(dir, "received_bytes", "lkc-1", "dt=2021-01-02", "20210102T000000Z-20210103T000000Z.csv")
+	_, err = os.Stat(path)
+	assert.True(os.IsNotExist(err), "Partial chunks shouldn't be written")
+
+	client.failPage = 0
+	summary, err = b.Run(context.Background(), job())
+	assert.Nil(err)
+	assert.Equal(3, summary.Written)
+
+	js, err := ioutil.ReadFile(path)
+	assert.Nil(err)
+	lines := strings.Split(strings.TrimSpace(string(js)), "\n")
+	assert.Len(lines, 4, "Expected a header and a row for every page")
+	assert.True(strings.HasSuffix(lines[3], ",orders,2"), lines[3])
+}
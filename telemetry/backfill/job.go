@@ -0,0 +1,142 @@
+package backfill
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/group"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+)
+
+const (
+	//MaxChunkDuration is the largest window of time fetched by a single chunk, so each chunk lands within a single date partition
+	MaxChunkDuration time.Duration = 24 * time.Hour
+
+	fileTimeFormat string = "20060102T150405Z"
+)
+
+//Job describes the history to backfill: the metrics of a set of resources, aggregated up to a granularity, over a date range
+type Job struct {
+	Resource    labels.Resource
+	ResourceIDs []string
+	Metrics     []metric.Metric
+	GroupBy     []labels.Label
+	Granularity granularity.Granularity
+	Start       time.Time
+	End         time.Time
+}
+
+//Validate checks that the job has everything needed to be split into chunks
+func (j Job) Validate() error {
+	if len(j.ResourceIDs) <= 0 {
+		return errors.New("At least 1 Resource ID is required to backfill")
+	}
+	if len(j.Metrics) <= 0 {
+		return errors.New("At least 1 Metric is required to backfill")
+	}
+	if _, err := granularity.Parse(j.Granularity.String()); err != nil || j.Granularity.Equals(granularity.All) {
+		return fmt.Errorf("Granularity `%s` can not be backfilled", j.Granularity)
+	}
+	if !j.Start.Before(j.End) {
+		return errors.New("Start must be before End")
+	}
+	return nil
+}
+
+//Chunks splits the job into API compliant chunks, one per resource, metric and window of time.
+//Windows are aligned to the granularity, never cross a UTC day, and never exceed the granularity's max duration.
+//Every max duration evenly divides a day, so aligning windows to their own size keeps them within a single day.
+func (j Job) Chunks() ([]Chunk, error) {
+	if err := j.Validate(); err != nil {
+		return nil, err
+	}
+
+	step := j.Granularity.Duration
+	window := MaxChunkDuration
+	if j.Granularity.MaxDuration < window {
+		window = j.Granularity.MaxDuration
+	}
+	if step > window {
+		window = step
+	}
+
+	start := j.Start.UTC().Truncate(step)
+	end := j.End.UTC().Truncate(step)
+	if end.Before(j.End) {
+		end = end.Add(step)
+	}
+
+	chunks := []Chunk{}
+	for _, id := range j.ResourceIDs {
+		for _, m := range j.Metrics {
+			for t := start; t.Before(end); {
+				chunkEnd := t.Add(window).Truncate(window)
+				if chunkEnd.After(end) {
+					chunkEnd = end
+				}
+
+				chunks = append(chunks, Chunk{
+					Resource:    j.Resource,
+					ResourceID:  id,
+					Metric:      m,
+					GroupBy:     j.GroupBy,
+					Granularity: j.Granularity,
+					Start:       t,
+					End:         chunkEnd,
+				})
+				t = chunkEnd
+			}
+		}
+	}
+	return chunks, nil
+}
+
+//Chunk is a single query's worth of a Job
+type Chunk struct {
+	Resource    labels.Resource
+	ResourceID  string
+	Metric      metric.Metric
+	GroupBy     []labels.Label
+	Granularity granularity.Granularity
+	Start       time.Time
+	End         time.Time
+}
+
+//Query builds the Metric Query for the chunk
+func (c Chunk) Query(limit int) query.Query {
+	return query.Query{
+		Filter:       filter.EqualTo(c.Resource, c.ResourceID),
+		Intervals:    interval.Of(interval.Between(c.Start, c.End)),
+		Aggregations: agg.Of(agg.DefaultOf(c.Metric, c.GroupBy...)),
+		Granularity:  c.Granularity,
+		GroupBy:      group.Of(c.Resource).And(c.GroupBy...),
+		Limit:        limit,
+	}
+}
+
+//Path returns the date partitioned path, relative to the output directory, the chunk is written to
+func (c Chunk) Path(format Format) string {
+	return filepath.Join(
+		sanitize(c.Metric.ShortName()),
+		sanitize(c.ResourceID),
+		"dt="+c.Start.Format("2006-01-02"),
+		c.Start.Format(fileTimeFormat)+"-"+c.End.Format(fileTimeFormat)+"."+string(format),
+	)
+}
+
+func (c Chunk) String() string {
+	return fmt.Sprintf("%s/%s [%s, %s)", c.ResourceID, c.Metric.ShortName(), c.Start.Format(time.RFC3339), c.End.Format(time.RFC3339))
+}
+
+func sanitize(name string) string {
+	return strings.NewReplacer("/", "_", "\\", "_", ":", "_").Replace(name)
+}
@@ -0,0 +1,115 @@
+package backfill
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+)
+
+const (
+	//FormatJSONL is a static def for writing one JSON record per line
+	FormatJSONL Format = "jsonl"
+	//FormatCSV is a static def for writing CSV with a header row
+	FormatCSV Format = "csv"
+)
+
+//Format is the file format chunks are written out as
+type Format string
+
+//ParseFormat parses a Format from its name
+func ParseFormat(f string) (Format, error) {
+	switch Format(f) {
+	case FormatJSONL, FormatCSV:
+		return Format(f), nil
+	default:
+		return "", fmt.Errorf("Unknown format `%s`. Expected one of `%s` or `%s`", f, FormatJSONL, FormatCSV)
+	}
+}
+
+//Record is a single backfilled data point
+type Record struct {
+	Timestamp  time.Time         `json:"timestamp"`
+	Metric     string            `json:"metric"`
+	ResourceID string            `json:"resource_id"`
+	Value      float64           `json:"value"`
+	Labels     map[string]string `json:"labels,omitempty"`
+}
+
+//NewRecords converts the data points of a chunk into Records
+func NewRecords(chunk Chunk, data []response.Telemetry) []Record {
+	records := make([]Record, len(data))
+	for i, d := range data {
+		records[i] = Record{
+			Timestamp:  d.Timestamp,
+			Metric:     chunk.Metric.Name,
+			ResourceID: chunk.ResourceID,
+			Value:      d.Value,
+			Labels:     map[string]string{},
+		}
+		for _, l := range chunk.GroupBy {
+			if v, ok := d.Label(l); ok {
+				records[i].Labels[labels.Key(l)] = v
+			}
+		}
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp.Before(records[j].Timestamp)
+	})
+	return records
+}
+
+//WriteRecords writes the records of a chunk out in the given Format
+func WriteRecords(w io.Writer, format Format, chunk Chunk, records []Record) error {
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, chunk, records)
+	case FormatJSONL:
+		enc := json.NewEncoder(w)
+		for _, r := range records {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("Unknown format `%s`", format)
+	}
+}
+
+func writeCSV(w io.Writer, chunk Chunk, records []Record) error {
+	labelKeys := make([]string, len(chunk.GroupBy))
+	for i, l := range chunk.GroupBy {
+		labelKeys[i] = labels.Key(l)
+	}
+
+	out := csv.NewWriter(w)
+	if err := out.Write(append([]string{"timestamp", "metric", "resource_id", "value"}, labelKeys...)); err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		row := []string{
+			r.Timestamp.Format(time.RFC3339),
+			r.Metric,
+			r.ResourceID,
+			strconv.FormatFloat(r.Value, 'f', -1, 64),
+		}
+		for _, k := range labelKeys {
+			row = append(row, r.Labels[k])
+		}
+		if err := out.Write(row); err != nil {
+			return err
+		}
+	}
+
+	out.Flush()
+	return out.Error()
+}
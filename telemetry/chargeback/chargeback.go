@@ -0,0 +1,179 @@
+package chargeback
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/group"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+)
+
+const (
+	//DefaultPageLimit is the default query limit used when fetching usage
+	DefaultPageLimit int = telemetry.DefaultQueryLimit
+)
+
+//TeamLookup maps a Principal, such as a Service Account ID, to the name of the team that owns it
+type TeamLookup interface {
+	Team(principal string) (string, bool)
+}
+
+//TeamLookupFunc allows a simple func to be used as a TeamLookup
+type TeamLookupFunc func(principal string) (string, bool)
+
+func (f TeamLookupFunc) Team(principal string) (string, bool) {
+	return f(principal)
+}
+
+//TeamMap is a static TeamLookup of Principal to team name
+type TeamMap map[string]string
+
+func (m TeamMap) Team(principal string) (string, bool) {
+	team, ok := m[principal]
+	return team, ok
+}
+
+//Chargeback builds usage reports, per Principal, for charging Kafka usage back to internal teams
+type Chargeback struct {
+	Client   telemetry.PagedMetricQuerier
+	Teams    TeamLookup
+	BytesIn  metric.Metric
+	BytesOut metric.Metric
+	Requests metric.Metric
+	//ByTopic breaks the usage of each Principal down by topic. Every metric must carry the `metric.topic` label, which the default request metrics don't, or the Report fails
+	ByTopic   bool
+	PageLimit int
+}
+
+//New creates a new Chargeback, using the request and response bytes metrics, for a given TelemetryClient
+func New(client telemetry.PagedMetricQuerier) Chargeback {
+	return Chargeback{
+		Client:    client,
+		BytesIn:   metric.KafkaServerRequestBytes,
+		BytesOut:  metric.KafkaServerResponseBytes,
+		Requests:  metric.KafkaServerRequests,
+		PageLimit: DefaultPageLimit,
+	}
+}
+
+//WithTeams returns a copy of the Chargeback that maps Principals to teams using the given TeamLookup
+func (c Chargeback) WithTeams(teams TeamLookup) Chargeback {
+	c.Teams = teams
+	return c
+}
+
+//Report queries the usage of every Principal of a given Kafka Cluster over a billing period.
+//Every page of usage is fetched, so that no Principal is left out of the Report.
+func (c Chargeback) Report(cluster string, period interval.Interval) (Report, error) {
+	report := Report{
+		Cluster: cluster,
+		Period:  period,
+	}
+
+	usage := map[usageKey]*Usage{}
+	fields := []struct {
+		metric metric.Metric
+		add    func(u *Usage, value float64)
+	}{
+		{c.BytesIn, func(u *Usage, value float64) { u.BytesIn += value }},
+		{c.BytesOut, func(u *Usage, value float64) { u.BytesOut += value }},
+		{c.Requests, func(u *Usage, value float64) { u.Requests += value }},
+	}
+
+	if c.ByTopic {
+		for _, f := range fields {
+			if f.metric.Name != "" && !hasLabel(f.metric, labels.MetricTopic) {
+				return report, fmt.Errorf("Metric `%s` has no `%s` label to report usage by topic with", f.metric.Name, labels.Key(labels.MetricTopic))
+			}
+		}
+	}
+
+	for _, f := range fields {
+		if f.metric.Name == "" {
+			continue
+		}
+
+		groupBy := group.Of(labels.ResourceKafka, labels.MetricPrincipalID)
+		if c.ByTopic {
+			groupBy = groupBy.And(labels.MetricTopic)
+		}
+
+		res, err := telemetry.QueryAllPages(c.Client, query.Query{
+			Filter:       filter.EqualTo(labels.ResourceKafka, cluster),
+			Intervals:    interval.Of(period),
+			Aggregations: agg.Of(agg.DefaultOf(f.metric, groupBy.Labels...)),
+			Granularity:  period.MaxGranularity(),
+			GroupBy:      groupBy,
+			Limit:        c.PageLimit,
+		})
+		if err != nil {
+			return report, err
+		}
+
+		for _, d := range res.Data {
+			key := usageKey{}
+			key.principal, _ = d.Label(labels.MetricPrincipalID)
+			if c.ByTopic {
+				key.topic, _ = d.Label(labels.MetricTopic)
+			}
+
+			u, ok := usage[key]
+			if !ok {
+				u = &Usage{
+					Principal: key.principal,
+					Team:      c.team(key.principal),
+					Topic:     key.topic,
+				}
+				usage[key] = u
+			}
+			f.add(u, d.Value)
+		}
+	}
+
+	for _, u := range usage {
+		report.Usage = append(report.Usage, *u)
+	}
+	sortUsage(report.Usage)
+
+	return report, nil
+}
+
+//hasLabel checks the metric's labels, falling back to those of the known metric of the same name
+func hasLabel(m metric.Metric, l labels.Metric) bool {
+	if m.HasLabel(l) {
+		return true
+	}
+	known, ok := metric.Lookup(m.Name)
+	return ok && known.HasLabel(l)
+}
+
+func (c Chargeback) team(principal string) string {
+	if c.Teams == nil {
+		return ""
+	}
+	team, _ := c.Teams.Team(principal)
+	return team
+}
+
+type usageKey struct {
+	principal string
+	topic     string
+}
+
+func sortUsage(usage []Usage) {
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].Team != usage[j].Team {
+			return usage[i].Team < usage[j].Team
+		}
+		if usage[i].Principal != usage[j].Principal {
+			return usage[i].Principal < usage[j].Principal
+		}
+		return usage[i].Topic < usage[j].Topic
+	})
+}
@@ -0,0 +1,95 @@
+package chargeback
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+	"github.com/stretchr/testify/assert"
+)
+
+//fakeQuerier serves a page per data point, to check every page is fetched
+type fakeQuerier map[string][]response.Telemetry
+
+func (f fakeQuerier) PostMetricsQuery(q query.Query) (response.Query, error) {
+	return f.PostMetricsQueryPage(q, "")
+}
+
+func (f fakeQuerier) PostMetricsQueryPage(q query.Query, pageToken string) (response.Query, error) {
+	data := f[q.Aggregations[0].Metric]
+	i, _ := strconv.Atoi(pageToken)
+	res := response.Query{BaseResponse: &response.BaseResponse{}}
+	if i < len(data) {
+		res.Data = data[i : i+1]
+	}
+	if i+1 < len(data) {
+		res.Meta.Pagination.NextPageToken = strconv.Itoa(i + 1)
+	}
+	return res, nil
+}
+
+func principalPoint(principal string, value float64) response.Telemetry {
+	return response.Telemetry{
+		Value: value,
+		Fields: map[string]interface{}{
+			labels.Key(labels.ResourceKafka):     "lkc-1",
+			labels.Key(labels.MetricPrincipalID): principal,
+		},
+	}
+}
+
+func TestChargeback_Report(t *testing.T) {
+	assert := assert.New(t)
+
+	client := fakeQuerier{
+		metric.KafkaServerRequestBytes.Name:  {principalPoint("sa-1", 100), principalPoint("sa-2", 50), principalPoint("sa-1", 20)},
+		metric.KafkaServerResponseBytes.Name: {principalPoint("sa-1", 300)},
+		metric.KafkaServerRequests.Name:      {principalPoint("sa-2", 7)},
+	}
+
+	cb := New(client).WithTeams(TeamMap{"sa-1": "payments", "sa-2": "orders"})
+	report, err := cb.Report("lkc-1", interval.EndingAt(24*time.Hour, time.Now()))
+	assert.Nil(err)
+
+	assert.Equal([]Usage{
+		{Principal: "sa-2", Team: "orders", BytesIn: 50, Requests: 7},
+		{Principal: "sa-1", Team: "payments", BytesIn: 120, BytesOut: 300},
+	}, report.Usage)
+
+	byTeam := report.ByTeam()
+	assert.Len(byTeam, 2)
+	assert.Equal(Usage{Team: "orders", BytesIn: 50, Requests: 7}, byTeam[0])
+
+	out := &bytes.Buffer{}
+	assert.Nil(report.WriteCSV(out))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(lines, 3, "Expected a header and 1 row per principal")
+	assert.Equal(strings.Join(CSVHeader, ","), lines[0])
+	assert.True(strings.HasSuffix(lines[2], ",payments,sa-1,,120,300,0"), lines[2])
+}
+
+func TestChargeback_ReportByTopic(t *testing.T) {
+	assert := assert.New(t)
+
+	topicBytes := metric.New("io.confluent.kafka.server/principal_topic_bytes", labels.MetricPrincipalID, labels.MetricTopic)
+	point := principalPoint("sa-1", 10)
+	point.Fields[labels.Key(labels.MetricTopic)] = "orders"
+	client := fakeQuerier{topicBytes.Name: {point}}
+
+	cb := New(client)
+	cb.ByTopic = true
+	_, err := cb.Report("lkc-1", interval.EndingAt(24*time.Hour, time.Now()))
+	assert.Error(err, "The default request metrics have no topic label to report by")
+
+	cb.BytesIn, cb.BytesOut, cb.Requests = topicBytes, metric.Metric{}, metric.Metric{}
+	report, err := cb.Report("lkc-1", interval.EndingAt(24*time.Hour, time.Now()))
+	assert.NoError(err)
+	assert.Equal([]Usage{{Principal: "sa-1", Topic: "orders", BytesIn: 10}}, report.Usage)
+}
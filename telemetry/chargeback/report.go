@@ -0,0 +1,107 @@
+package chargeback
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+)
+
+var (
+	//CSVHeader is the header row written out by Report.WriteCSV
+	CSVHeader = []string{"cluster", "period", "team", "principal", "topic", "bytes_in", "bytes_out", "requests"}
+)
+
+//Usage is the Kafka usage of a single Principal, and optionally a single topic, over a billing period
+type Usage struct {
+	Principal string  `json:"principal"`
+	Team      string  `json:"team,omitempty"`
+	Topic     string  `json:"topic,omitempty"`
+	BytesIn   float64 `json:"bytes_in"`
+	BytesOut  float64 `json:"bytes_out"`
+	Requests  float64 `json:"requests"`
+}
+
+//Add sums the usage of another Usage into this one
+func (u *Usage) Add(other Usage) {
+	u.BytesIn += other.BytesIn
+	u.BytesOut += other.BytesOut
+	u.Requests += other.Requests
+}
+
+//Report is the usage of all Principals of a Kafka Cluster over a billing period
+type Report struct {
+	Cluster string            `json:"cluster"`
+	Period  interval.Interval `json:"period"`
+	Usage   []Usage           `json:"usage"`
+}
+
+//ByPrincipal rolls the usage up to a single Usage per Principal
+func (r Report) ByPrincipal() []Usage {
+	return r.rollup(func(u Usage) Usage {
+		return Usage{Principal: u.Principal, Team: u.Team}
+	})
+}
+
+//ByTeam rolls the usage up to a single Usage per team
+func (r Report) ByTeam() []Usage {
+	return r.rollup(func(u Usage) Usage {
+		return Usage{Team: u.Team}
+	})
+}
+
+//ByTopic rolls the usage up to a single Usage per topic. Usage of metrics that have no topic detail is rolled up under an empty topic.
+func (r Report) ByTopic() []Usage {
+	return r.rollup(func(u Usage) Usage {
+		return Usage{Topic: u.Topic}
+	})
+}
+
+func (r Report) rollup(keyOf func(u Usage) Usage) []Usage {
+	order := []Usage{}
+	rolled := map[Usage]*Usage{}
+	for _, u := range r.Usage {
+		key := keyOf(u)
+		if _, ok := rolled[key]; !ok {
+			total := key
+			rolled[key] = &total
+			order = append(order, key)
+		}
+		rolled[key].Add(u)
+	}
+
+	results := make([]Usage, len(order))
+	for i, key := range order {
+		results[i] = *rolled[key]
+	}
+	sortUsage(results)
+	return results
+}
+
+//WriteCSV writes the report, with a header row, out as CSV
+func (r Report) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(CSVHeader); err != nil {
+		return err
+	}
+
+	for _, u := range r.Usage {
+		err := out.Write([]string{
+			r.Cluster,
+			r.Period.String(),
+			u.Team,
+			u.Principal,
+			u.Topic,
+			strconv.FormatFloat(u.BytesIn, 'f', -1, 64),
+			strconv.FormatFloat(u.BytesOut, 'f', -1, 64),
+			strconv.FormatFloat(u.Requests, 'f', -1, 64),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	out.Flush()
+	return out.Error()
+}
@@ -0,0 +1,158 @@
+package telemetry
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/resourcetype"
+)
+
+//DiscoveryOptions controls which additional details are looked up for each discovered Kafka Cluster
+type DiscoveryOptions struct {
+	Topics       bool
+	RequestTypes bool
+	Partitions   bool
+}
+
+//DiscoveredResource represents a resource that has reported metrics within the discovery window
+type DiscoveredResource struct {
+	Type         resourcetype.ResourceType
+	ID           string
+	Topics       []string
+	RequestTypes []string
+	Partitions   map[string][]string
+}
+
+//DiscoverResources returns the active resources, keyed by resource type, that have reported metrics within the given window of time.
+//When no resource types are given all the known resource types are discovered.
+func (client *TelemetryClient) DiscoverResources(inter interval.Interval, opts DiscoveryOptions, resourceTypes ...resourcetype.ResourceType) (map[string][]DiscoveredResource, error) {
+	if len(resourceTypes) == 0 {
+		resourceTypes = resourcetype.KnownResourceTypes
+	}
+
+	results := make(map[string][]DiscoveredResource, len(resourceTypes))
+	for _, resourceType := range resourceTypes {
+		ids, err := client.DiscoverResourceIDs(resourceType, inter)
+		if err != nil {
+			return results, err
+		}
+
+		resources := make([]DiscoveredResource, len(ids))
+		for i, id := range ids {
+			resources[i] = DiscoveredResource{
+				Type: resourceType,
+				ID:   id,
+			}
+			if resourceType.Type == resourcetype.ResourceTypeKafka.Type {
+				err := client.discoverKafkaDetails(&resources[i], inter, opts)
+				if err != nil {
+					return results, err
+				}
+			}
+		}
+		results[resourceType.Type] = resources
+	}
+
+	return results, nil
+}
+
+//DiscoverResourceIDs returns the IDs of all the resources of a given type that have reported metrics within the given window of time.
+//Every known metric of the resource type is probed, as not every resource reports every metric, such as an idle Connector that has sent no records.
+func (client *TelemetryClient) DiscoverResourceIDs(resourceType resourcetype.ResourceType, inter interval.Interval) ([]string, error) {
+	if len(resourceType.Labels) <= 0 {
+		return nil, fmt.Errorf("Resource Type `%s` has no resource label to discover by", resourceType.Type)
+	}
+
+	metrics := resourceType.KnownMetrics
+	if len(metrics) <= 0 {
+		return nil, fmt.Errorf("Resource Type `%s` has no known metrics to discover by", resourceType.Type)
+	}
+
+	label := resourceType.Labels[0]
+	ids := []string{}
+	for _, m := range metrics {
+		values, err := client.labelValues(nil, m, inter, label)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, values...)
+	}
+
+	return uniqueSorted(ids), nil
+}
+
+func (client *TelemetryClient) discoverKafkaDetails(resource *DiscoveredResource, inter interval.Interval, opts DiscoveryOptions) error {
+	clusterFilter := filter.EqualTo(labels.ResourceKafka, resource.ID)
+
+	if opts.Topics {
+		topics, err := client.labelValues(clusterFilter, metric.KafkaServerRetainedBytes, inter, labels.MetricTopic)
+		if err != nil {
+			return err
+		}
+		resource.Topics = topics
+	}
+
+	if opts.RequestTypes {
+		types, err := client.labelValues(clusterFilter, metric.KafkaServerRequests, inter, labels.MetricType)
+		if err != nil {
+			return err
+		}
+		resource.RequestTypes = types
+	}
+
+	if opts.Partitions {
+		tuples, err := client.LabelValues(LabelValuesQuery{
+			Metric:   metric.KafkaServerRetainedBytes,
+			Filter:   clusterFilter,
+			GroupBy:  []labels.Label{labels.MetricTopic, labels.MetricPartition},
+			Interval: inter,
+		})
+		if err != nil {
+			return err
+		}
+
+		resource.Partitions = map[string][]string{}
+		for _, t := range tuples {
+			topic := t[0].String()
+			resource.Partitions[topic] = append(resource.Partitions[topic], t[1].String())
+		}
+	}
+
+	return nil
+}
+
+//labelValues returns the values of a single label for a metric, optionally filtered, within the given window of time
+func (client *TelemetryClient) labelValues(fil filter.Filter, m metric.Metric, inter interval.Interval, label labels.Label) ([]string, error) {
+	tuples, err := client.LabelValues(LabelValuesQuery{
+		Metric:   m,
+		Filter:   fil,
+		GroupBy:  []labels.Label{label},
+		Interval: inter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, len(tuples))
+	for i, t := range tuples {
+		values[i] = t[0].String()
+	}
+	return values, nil
+}
+
+func uniqueSorted(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
@@ -0,0 +1,53 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/resourcetype"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/telemetrytest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscoverResources(t *testing.T) {
+	assert := assert.New(t)
+	srv, tc := newTestClient()
+	defer srv.Close()
+
+	srv.Add(
+		telemetrytest.NewPoint(metric.KafkaServerRetainedBytes.Name, testStart, 1, "resource.kafka.id", "lkc-1", "metric.topic", "orders", "metric.partition", "0"),
+		telemetrytest.NewPoint(metric.KafkaServerRetainedBytes.Name, testStart, 1, "resource.kafka.id", "lkc-1", "metric.topic", "orders", "metric.partition", "1"),
+		telemetrytest.NewPoint(metric.KafkaServerRetainedBytes.Name, testStart, 1, "resource.kafka.id", "lkc-1", "metric.topic", "payments", "metric.partition", "0"),
+		telemetrytest.NewPoint(metric.KafkaServerRequests.Name, testStart, 1, "resource.kafka.id", "lkc-1", "metric.type", "Produce"),
+		telemetrytest.NewPoint(metric.KafkaServerRequests.Name, testStart, 1, "resource.kafka.id", "lkc-1", "metric.type", "Fetch"),
+		telemetrytest.NewPoint(metric.KafkaServerReceivedBytes.Name, testStart, 1, "resource.kafka.id", "lkc-2"),
+		telemetrytest.NewPoint(metric.ConnectorDeadLetterQueueRecords.Name, testStart, 1, "resource.connector.id", "lcc-1"),
+	)
+
+	inter := interval.StartingFrom(testStart, time.Hour)
+	found, err := tc.DiscoverResources(inter, DiscoveryOptions{Topics: true, RequestTypes: true, Partitions: true})
+	assert.NoError(err)
+
+	kafka := found[resourcetype.ResourceTypeKafka.Type]
+	if assert.Len(kafka, 2, "Clusters only reporting some of the Kafka metrics should still be discovered") {
+		assert.Equal("lkc-1", kafka[0].ID)
+		assert.Equal([]string{"orders", "payments"}, kafka[0].Topics)
+		assert.Equal([]string{"Fetch", "Produce"}, kafka[0].RequestTypes)
+		assert.Equal(map[string][]string{"orders": {"0", "1"}, "payments": {"0"}}, kafka[0].Partitions)
+
+		assert.Equal("lkc-2", kafka[1].ID)
+		assert.Len(kafka[1].Topics, 0)
+	}
+
+	connectors := found[resourcetype.ResourceTypeConnector.Type]
+	if assert.Len(connectors, 1, "Connectors without data for the sent or received records should still be discovered") {
+		assert.Equal("lcc-1", connectors[0].ID)
+	}
+
+	assert.Len(found[resourcetype.ResourceTypeKSQL.Type], 0, "Resource types without any data should discover nothing")
+
+	_, err = tc.DiscoverResourceIDs(resourcetype.NewResourceType("unlabeled", nil), inter)
+	assert.Error(err, "Resource types without a label can't be discovered")
+}
@@ -0,0 +1,119 @@
+package telemetry
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/group"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/match"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+)
+
+//LabelValuesQuery describes a lookup of the distinct values, or tuples of values, of one or more labels for a given metric.
+//Filter is applied server side, where as Matchers are applied client side for patterns the API can't filter on.
+//
+//For example, listing all the partitions of topics matching `orders-*`:
+//
+//	LabelValuesQuery{
+//		Metric:   metric.KafkaServerRetainedBytes,
+//		Filter:   filter.EqualTo(labels.ResourceKafka, "lkc-1234"),
+//		GroupBy:  []labels.Label{labels.MetricTopic, labels.MetricPartition},
+//		Interval: interval.EndingAt(time.Hour, time.Now()),
+//		Matchers: []match.LabelMatcher{match.Label(labels.MetricTopic, match.Glob("orders-*"))},
+//	}
+type LabelValuesQuery struct {
+	Metric   metric.Metric
+	Filter   filter.Filter
+	GroupBy  []labels.Label
+	Interval interval.Interval
+	Matchers []match.LabelMatcher
+	//PageLimit is the number of results per page. Defaults to the TelemetryClient's PageLimit
+	PageLimit int
+	//MaxPages is the max number of pages to fetch. 0 fetches all the available pages
+	MaxPages int
+}
+
+//LabelValues returns the sorted and de-duplicated tuples of label values, in GroupBy order, matching the given query
+func (client *TelemetryClient) LabelValues(q LabelValuesQuery) ([]response.LabelTuple, error) {
+	if len(q.GroupBy) <= 0 {
+		return nil, errors.New("At least 1 Group By label is required to lookup label values")
+	}
+
+	pageLimit := q.PageLimit
+	if pageLimit <= 0 {
+		pageLimit = client.PageLimit
+	}
+
+	lblQuery := query.Query{
+		Filter:    q.Filter,
+		GroupBy:   group.Of(q.GroupBy...),
+		Intervals: interval.Of(q.Interval),
+		Metric:    q.Metric,
+		Limit:     pageLimit,
+	}
+
+	tuples := []response.LabelTuple{}
+	seen := map[string]bool{}
+	pageToken := ""
+	for page := 1; ; page++ {
+		res, err := client.PostLabelQueryPage(lblQuery, pageToken)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range res.Data {
+			tuple, ok := labelTuple(d, q.GroupBy)
+			if !ok || !matchesAll(tuple, q.Matchers) {
+				continue
+			}
+
+			key := strings.Join(tuple.Strings(), "\x00")
+			if !seen[key] {
+				seen[key] = true
+				tuples = append(tuples, tuple)
+			}
+		}
+
+		pageToken = res.NextPageToken()
+		if pageToken == "" || (q.MaxPages > 0 && page >= q.MaxPages) {
+			break
+		}
+	}
+
+	sortTuples(tuples)
+	return tuples, nil
+}
+
+func labelTuple(d response.Telemetry, groupBy []labels.Label) (response.LabelTuple, bool) {
+	tuple := make(response.LabelTuple, len(groupBy))
+	for i, l := range groupBy {
+		v, ok := d.LabelValue(l)
+		if !ok {
+			return nil, false
+		}
+		tuple[i] = v
+	}
+	return tuple, true
+}
+
+func matchesAll(tuple response.LabelTuple, matchers []match.LabelMatcher) bool {
+	for _, m := range matchers {
+		v, ok := tuple.Get(m.Label)
+		if !ok || !m.Matcher.Matches(v.String()) {
+			return false
+		}
+	}
+	return true
+}
+
+func sortTuples(tuples []response.LabelTuple) {
+	sort.SliceStable(tuples, func(i, j int) bool {
+		return tuples[i].Compare(tuples[j]) < 0
+	})
+}
@@ -3,6 +3,7 @@ package telemetry
 import (
 	"encoding/json"
 	"errors"
+	neturl "net/url"
 
 	"github.com/nerdynick/ccloud-go-sdk/logging"
 	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
@@ -16,7 +17,15 @@ import (
 )
 
 func (client *TelemetryClient) PostLabelQuery(query query.Query) (response.Query, error) {
+	return client.PostLabelQueryPage(query, "")
+}
+
+//PostLabelQueryPage POST a Label Query for a given page of results. An empty page token fetches the first page.
+func (client *TelemetryClient) PostLabelQueryPage(query query.Query, pageToken string) (response.Query, error) {
 	url := APIPathAttributes.Format(*client, 2)
+	if pageToken != "" {
+		url += "?page_token=" + neturl.QueryEscape(pageToken)
+	}
 	response := response.Query{}
 
 	if len(query.GroupBy.Labels) <= 0 {
@@ -41,6 +50,8 @@ func (client *TelemetryClient) PostLabelQuery(query query.Query) (response.Query
 	return response, nil
 }
 
+//LabelQuery returns the values of a single label for a given metric and resource within a window of time, from the first page of results and in the order returned by the API.
+//Use LabelValues to fetch every page of sorted and de-duplicated values.
 func (client TelemetryClient) LabelQuery(resourceType labels.Resource, resourceID string, metric metric.Metric, field labels.Label, inter interval.Interval) ([]string, error) {
 	query := query.Query{
 		Filter:    filter.EqualTo(resourceType, resourceID),
@@ -56,7 +67,7 @@ func (client TelemetryClient) LabelQuery(resourceType labels.Resource, resourceI
 
 	values := make([]string, len(response.Data))
 	for i := 0; i < len(response.Data); i++ {
-		values[i] = response.Data[i].Fields[field.String()].(string)
+		values[i], _ = response.Data[i].Label(field)
 	}
 
 	return values, nil
@@ -0,0 +1,27 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/telemetrytest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelQuery(t *testing.T) {
+	assert := assert.New(t)
+	srv, tc := newTestClient()
+	defer srv.Close()
+
+	for p := 0; p < 3; p++ {
+		srv.Add(telemetrytest.NewPoint(metric.KafkaServerRetainedBytes.Name, testStart, 1, "resource.kafka.id", "lkc-1", "metric.topic", "orders", "metric.partition", string(rune('0'+p))))
+	}
+
+	values, err := tc.LabelQuery(labels.ResourceKafka, "lkc-1", metric.KafkaServerRetainedBytes, labels.MetricPartition, interval.StartingFrom(testStart, time.Hour))
+	assert.NoError(err)
+	assert.Equal([]string{"0", "1", "2"}, values, "Numeric label values should be returned as strings")
+	assert.Len(srv.Requests(), 1, "LabelQuery should only fetch the first page")
+}
@@ -0,0 +1,70 @@
+package telemetry
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/group"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+)
+
+//GetKafkaConsumerGroups returns all the Consumer Groups that have reported lag for a given Kafka Cluster within a window of time
+func (client TelemetryClient) GetKafkaConsumerGroups(cluster string, inter interval.Interval) ([]string, error) {
+	return client.labelValues(filter.EqualTo(labels.ResourceKafka, cluster), metric.KafkaServerConsumerLag, inter, labels.MetricConsumerGroupID)
+}
+
+//QueryKafkaConsumerGroupLag returns the total lag for a given Consumer Group, aggregated up to the given granularity, within the given window of time.
+//The total is the sum across all of the group's topic partitions of each partition's max lag within each bucket.
+func (client *TelemetryClient) QueryKafkaConsumerGroupLag(cluster string, granularity granularity.Granularity, inter interval.Interval, consumerGroup string) ([]response.Telemetry, error) {
+	lags, err := client.QueryKafkaConsumerLag(cluster, granularity, inter, consumerGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]response.Telemetry, len(lags))
+	for i, l := range lags {
+		results[i] = response.Telemetry{
+			Timestamp: l.Timestamp,
+			Value:     l.SumLag,
+			Metric:    metric.KafkaServerConsumerLag.Name,
+			Fields: map[string]interface{}{
+				labels.Key(labels.ResourceKafka):         cluster,
+				labels.Key(labels.MetricConsumerGroupID): l.ConsumerGroup,
+			},
+		}
+	}
+	return results, nil
+}
+
+//QueryKafkaConsumerLag returns the lag per topic partition, with the max and sum of lag, for a given Consumer Group, aggregated up to the given granularity, within the given window of time
+func (client *TelemetryClient) QueryKafkaConsumerLag(cluster string, granularity granularity.Granularity, inter interval.Interval, consumerGroup string) ([]response.ConsumerGroupLag, error) {
+	return client.queryKafkaConsumerLag(filter.EqualTo(labels.ResourceKafka, cluster).AndEqualTo(labels.MetricConsumerGroupID, consumerGroup), granularity, inter)
+}
+
+//QueryKafkaConsumerLagForAllGroups returns the lag per topic partition, with the max and sum of lag, for every Consumer Group of a given Kafka Cluster, aggregated up to the given granularity, within the given window of time
+func (client *TelemetryClient) QueryKafkaConsumerLagForAllGroups(cluster string, granularity granularity.Granularity, inter interval.Interval) ([]response.ConsumerGroupLag, error) {
+	return client.queryKafkaConsumerLag(filter.EqualTo(labels.ResourceKafka, cluster), granularity, inter)
+}
+
+//queryKafkaConsumerLag fetches every page of lag per topic partition. As lag is a gauge, the MAX within each bucket is used, as summing the points of a bucket would inflate it.
+func (client *TelemetryClient) queryKafkaConsumerLag(fil filter.Filter, granularity granularity.Granularity, inter interval.Interval) ([]response.ConsumerGroupLag, error) {
+	query := query.Query{
+		Filter:       fil,
+		Intervals:    interval.Of(inter),
+		Aggregations: agg.Of(agg.MaxOf(metric.KafkaServerConsumerLag)),
+		Granularity:  granularity,
+		GroupBy:      group.Of(labels.ResourceKafka).And(labels.MetricConsumerGroupID, labels.MetricTopic, labels.MetricPartition),
+		Limit:        client.PageLimit,
+	}
+
+	res, err := client.PostMetricsQueryAll(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ConsumerGroupLags(res.Data), nil
+}
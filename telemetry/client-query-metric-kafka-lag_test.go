@@ -0,0 +1,49 @@
+package telemetry
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/telemetrytest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryKafkaConsumerLag(t *testing.T) {
+	assert := assert.New(t)
+	srv, tc := newTestClient()
+	defer srv.Close()
+
+	lag := metric.KafkaServerConsumerLag.Name
+	for m := 0; m < 5; m++ {
+		ts := testStart.Add(time.Duration(m) * time.Minute)
+		for p := 0; p < 3; p++ {
+			srv.Add(telemetrytest.NewPoint(lag, ts, float64(10*(p+1)+m), "resource.kafka.id", "lkc-1", "metric.consumer_group_id", "billing", "metric.topic", "orders", "metric.partition", strconv.Itoa(p)))
+		}
+		srv.Add(telemetrytest.NewPoint(lag, ts, 5, "resource.kafka.id", "lkc-1", "metric.consumer_group_id", "audit", "metric.topic", "orders", "metric.partition", "0"))
+	}
+	tc.PageLimit = 2
+
+	inter := interval.StartingFrom(testStart, 5*time.Minute)
+	lags, err := tc.QueryKafkaConsumerLagForAllGroups("lkc-1", granularity.FiveMin, inter)
+	assert.NoError(err)
+	if assert.Len(lags, 2) {
+		assert.Equal("audit", lags[0].ConsumerGroup)
+		assert.Equal(5.0, lags[0].SumLag, "Lag is a gauge and shouldn't be summed over the bucket")
+
+		assert.Equal("billing", lags[1].ConsumerGroup)
+		assert.Len(lags[1].Partitions, 3, "Every page of partitions should be fetched")
+		assert.Equal(34.0, lags[1].MaxLag)
+		assert.Equal(14.0+24.0+34.0, lags[1].SumLag, "Each partition's max within the bucket should be summed")
+	}
+
+	total, err := tc.QueryKafkaConsumerGroupLag("lkc-1", granularity.FiveMin, inter, "billing")
+	assert.NoError(err)
+	if assert.Len(total, 1) {
+		assert.Equal(72.0, total[0].Value, "The total should be summed across partitions")
+		assert.Equal("billing", total[0].Fields["metric.consumer_group_id"])
+	}
+}
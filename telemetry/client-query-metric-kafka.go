@@ -27,7 +27,8 @@ func (client *TelemetryClient) QueryKafkaMetricAndTopic(resourceID string, granu
 	return client.QueryMetricAndLabel(labels.ResourceKafka, resourceID, granularity, inter, metric, labels.MetricTopic, topic)
 }
 
-//QueryMetricAndTopicWithPartitions returns all the data points for a given metric and topic, aggregated up to the given granularity, within the given window of time, including aggregations to the partition
+//QueryMetricAndTopicWithPartitions returns all the data points for a given metric and topic, aggregated up to the given granularity, within the given window of time, including aggregations to the partition.
+//Every page of results is fetched
 func (client *TelemetryClient) QueryKafkaMetricAndTopicWithPartitions(resourceID string, granularity granularity.Granularity, inter interval.Interval, metric metric.Metric, topic string) ([]response.Telemetry, error) {
 	query := query.Query{
 		Filter:       filter.EqualTo(labels.ResourceKafka, resourceID),
@@ -38,7 +39,7 @@ func (client *TelemetryClient) QueryKafkaMetricAndTopicWithPartitions(resourceID
 		Limit:        client.PageLimit,
 	}
 
-	response, err := client.PostMetricsQuery(query)
+	response, err := client.PostMetricsQueryAll(query)
 	for i, r := range response.Data {
 		d := r
 		d.Metric = metric.Name
@@ -47,7 +48,8 @@ func (client *TelemetryClient) QueryKafkaMetricAndTopicWithPartitions(resourceID
 	return response.Data, err
 }
 
-//QueryMetricForAllTopics returns all the data points, fetched in parallel, for a given metric and all available topics (As returned by GetTopicsForMetric), aggregated up to the given granularity, within the given window of time
+//QueryMetricForAllTopics returns all the data points, fetched in parallel, for a given metric and all available topics (As returned by GetTopicsForMetric), aggregated up to the given granularity, within the given window of time.
+//Every page of results is fetched, so that no topic is missing from the results
 func (client *TelemetryClient) QueryKafkaMetricForAllTopics(resourceID string, granularity granularity.Granularity, inter interval.Interval, metric metric.Metric) ([]response.Telemetry, error) {
 	query := query.Query{
 		Filter:       filter.EqualTo(labels.ResourceKafka, resourceID),
@@ -58,7 +60,7 @@ func (client *TelemetryClient) QueryKafkaMetricForAllTopics(resourceID string, g
 		Limit:        client.PageLimit,
 	}
 
-	response, err := client.PostMetricsQuery(query)
+	response, err := client.PostMetricsQueryAll(query)
 	for i, r := range response.Data {
 		d := r
 		d.Metric = metric.Name
@@ -0,0 +1,38 @@
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/telemetrytest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryKafkaMetricForAllTopics(t *testing.T) {
+	assert := assert.New(t)
+	srv, tc := newTestClient()
+	defer srv.Close()
+
+	topics := []string{"a", "b", "c", "d", "e"}
+	for _, topic := range topics {
+		srv.Add(telemetrytest.NewPoint(metric.KafkaServerReceivedBytes.Name, testStart, 1, "resource.kafka.id", "lkc-1", "metric.topic", topic, "metric.partition", "0"))
+	}
+	tc.PageLimit = 2
+
+	res, err := tc.QueryKafkaMetricForAllTopics("lkc-1", granularity.OneHour, interval.StartingFrom(testStart, time.Hour), metric.KafkaServerReceivedBytes)
+	assert.NoError(err)
+	seen := []string{}
+	for _, d := range res {
+		seen = append(seen, d.Fields["metric.topic"].(string))
+		assert.Equal(metric.KafkaServerReceivedBytes.Name, d.Metric)
+	}
+	assert.ElementsMatch(topics, seen, "Topics past the first page should be returned")
+	assert.Len(srv.Requests(), 3)
+
+	res, err = tc.QueryKafkaMetricAndTopicWithPartitions("lkc-1", granularity.OneHour, interval.StartingFrom(testStart, time.Hour), metric.KafkaServerReceivedBytes, "*")
+	assert.NoError(err)
+	assert.Len(res, len(topics), "Partitions past the first page should be returned")
+}
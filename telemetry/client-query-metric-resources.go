@@ -0,0 +1,64 @@
+package telemetry
+
+import (
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/group"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+	"go.uber.org/zap"
+)
+
+//QueryMetricForResources returns all the data points for a given metric across many resources, keyed by resource ID, aggregated up to the given granularity, within the given window of time.
+//Resources are OR'ed together into as few queries as possible, limited to MaxResourcesPerQuery per query, and every page of each query is fetched.
+func (client *TelemetryClient) QueryMetricForResources(resourceType labels.Resource, resourceIDs []string, granularity granularity.Granularity, inter interval.Interval, metric metric.Metric) (map[string][]response.Telemetry, error) {
+	results := make(map[string][]response.Telemetry)
+
+	for _, fil := range filter.InChunks(resourceType, client.MaxResourcesPerQuery, resourceIDs...) {
+		query := query.Query{
+			Filter:       fil,
+			Intervals:    interval.Of(inter),
+			Aggregations: agg.Of(agg.SumOf(metric)),
+			Granularity:  granularity,
+			GroupBy:      group.Of(resourceType),
+			Limit:        client.PageLimit,
+		}
+
+		client.Log.Debug("Querying Metric for Resources",
+			zap.String("metric", metric.Name),
+			zap.Int("resources", len(fil.Filters)),
+		)
+
+		response, err := client.PostMetricsQueryAll(query)
+		if err != nil {
+			return results, err
+		}
+
+		for _, d := range response.Data {
+			d.Metric = metric.Name
+			id, _ := d.Label(resourceType)
+			results[id] = append(results[id], d)
+		}
+	}
+
+	return results, nil
+}
+
+//QueryKafkaMetricForClusters returns all the data points for a given metric across many Kafka Clusters, keyed by cluster ID
+func (client *TelemetryClient) QueryKafkaMetricForClusters(clusterIDs []string, granularity granularity.Granularity, inter interval.Interval, metric metric.Metric) (map[string][]response.Telemetry, error) {
+	return client.QueryMetricForResources(labels.ResourceKafka, clusterIDs, granularity, inter, metric)
+}
+
+//QueryConnectorMetricForConnectors returns all the data points for a given metric across many Connectors, keyed by connector ID
+func (client *TelemetryClient) QueryConnectorMetricForConnectors(connectorIDs []string, granularity granularity.Granularity, inter interval.Interval, metric metric.Metric) (map[string][]response.Telemetry, error) {
+	return client.QueryMetricForResources(labels.ResourceConnector, connectorIDs, granularity, inter, metric)
+}
+
+//QueryKSQLMetricForApps returns all the data points for a given metric across many ksqlDB Apps, keyed by ksqlDB App ID
+func (client *TelemetryClient) QueryKSQLMetricForApps(ksqlIDs []string, granularity granularity.Granularity, inter interval.Interval, metric metric.Metric) (map[string][]response.Telemetry, error) {
+	return client.QueryMetricForResources(labels.ResourceKSQL, ksqlIDs, granularity, inter, metric)
+}
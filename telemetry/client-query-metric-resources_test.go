@@ -0,0 +1,54 @@
+package telemetry
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/telemetrytest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryMetricForResources(t *testing.T) {
+	assert := assert.New(t)
+	srv, tc := newTestClient()
+	defer srv.Close()
+
+	ids := []string{"lkc-1", "lkc-2", "lkc-3"}
+	for _, id := range ids {
+		for h := 0; h < 3; h++ {
+			srv.Add(telemetrytest.NewPoint(metric.KafkaServerReceivedBytes.Name, testStart.Add(time.Duration(h)*time.Hour), 1, "resource.kafka.id", id))
+		}
+	}
+	tc.PageLimit = 2
+	tc.MaxResourcesPerQuery = 2
+
+	res, err := tc.QueryMetricForResources(labels.ResourceKafka, append(ids, "lkc-1"), granularity.OneHour, interval.StartingFrom(testStart, 3*time.Hour), metric.KafkaServerReceivedBytes)
+	assert.NoError(err)
+	for _, id := range ids {
+		assert.Len(res[id], 3, "Every page should be fetched for %s", id)
+	}
+	assert.Len(srv.Requests(), 5, "2 queries of 2 resources should take 3 pages and 1 query of 1 resource 2 pages")
+}
+
+func TestQueryMetricForResources_Gauge(t *testing.T) {
+	assert := assert.New(t)
+	srv := telemetrytest.NewServer()
+	defer srv.Close()
+	tc := New("key", "secret")
+	tc.Context.BaseURL = srv.URL
+
+	start := time.Date(2021, 4, 20, 0, 0, 0, 0, time.UTC)
+	for p, v := range []float64{100, 200, 300} {
+		srv.Add(telemetrytest.NewPoint(metric.KafkaServerRetainedBytes.Name, start, v, "resource.kafka.id", "lkc-1", "metric.topic", "orders", "metric.partition", strconv.Itoa(p)))
+	}
+
+	res, err := tc.QueryMetricForResources(labels.ResourceKafka, []string{"lkc-1"}, granularity.OneHour, interval.StartingFrom(start, time.Hour), metric.KafkaServerRetainedBytes)
+	assert.NoError(err)
+	assert.Len(res["lkc-1"], 1)
+	assert.Equal(600.0, res["lkc-1"][0].Value, "A gauge should be totalled across the partitions of a Cluster")
+}
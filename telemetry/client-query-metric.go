@@ -3,7 +3,9 @@ package telemetry
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"math"
+	neturl "net/url"
 	"time"
 
 	"github.com/nerdynick/ccloud-go-sdk/logging"
@@ -19,15 +21,34 @@ import (
 	"go.uber.org/zap"
 )
 
+//MetricQuerier is anything that can run Metric Queries, such as the TelemetryClient. Useful for swapping in a fake while testing.
+type MetricQuerier interface {
+	PostMetricsQuery(query query.Query) (response.Query, error)
+}
+
+//PagedMetricQuerier is a MetricQuerier that can also fetch the following pages of a Metric Query's results
+type PagedMetricQuerier interface {
+	MetricQuerier
+	PostMetricsQueryPage(query query.Query, pageToken string) (response.Query, error)
+}
+
 func (client *TelemetryClient) PostMetricsQuery(query query.Query) (response.Query, error) {
-	url := APIPathDescriptor.Format(*client, 2)
+	return client.PostMetricsQueryPage(query, "")
+}
+
+//PostMetricsQueryPage POST a Metric Query for a given page of results. An empty page token fetches the first page.
+func (client *TelemetryClient) PostMetricsQueryPage(query query.Query, pageToken string) (response.Query, error) {
+	url := APIPathQuery.Format(*client, 2)
+	if pageToken != "" {
+		url += "?page_token=" + neturl.QueryEscape(pageToken)
+	}
 	response := response.Query{}
 
 	if len(query.Aggregations) <= 0 {
 		return response, errors.New("Aggregations are required for Metric Queries")
 	}
 
-	if query.Granularity.IsValid() {
+	if !query.Granularity.IsValid() {
 		return response, errors.New("Granularity is a required field and must be a valid value")
 	}
 
@@ -53,6 +74,35 @@ func (client *TelemetryClient) PostMetricsQuery(query query.Query) (response.Que
 	return response, nil
 }
 
+//PostMetricsQueryAll POST a Metric Query, following the next page tokens until every page of results has been fetched
+func (client *TelemetryClient) PostMetricsQueryAll(query query.Query) (response.Query, error) {
+	return QueryAllPages(client, query)
+}
+
+//QueryAllPages runs a Metric Query, following the next page tokens until every page of results has been fetched.
+//The data of all the pages is returned together. Should any page fail, no data is returned, so that partial results are never mistaken for complete ones.
+func QueryAllPages(querier PagedMetricQuerier, q query.Query) (response.Query, error) {
+	all := response.Query{}
+	pageToken := ""
+	for {
+		res, err := querier.PostMetricsQueryPage(q, pageToken)
+		if err != nil {
+			return response.Query{}, err
+		}
+		all.BaseResponse = res.BaseResponse
+		all.Data = append(all.Data, res.Data...)
+
+		next := res.NextPageToken()
+		if next == "" {
+			return all, nil
+		}
+		if next == pageToken {
+			return response.Query{}, fmt.Errorf("Page token `%s` was returned twice", next)
+		}
+		pageToken = next
+	}
+}
+
 func (client *TelemetryClient) PostMetricsQueryAsync(queryChan <-chan query.Query, resultsChan chan<- response.Query, errsChan chan<- error) {
 	for q := range queryChan {
 		r, e := client.PostMetricsQuery(q)
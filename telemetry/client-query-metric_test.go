@@ -0,0 +1,91 @@
+package telemetry
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/telemetrytest"
+	"github.com/stretchr/testify/assert"
+)
+
+var testStart = time.Date(2021, 4, 20, 0, 0, 0, 0, time.UTC)
+
+func newTestClient() (*telemetrytest.Server, *TelemetryClient) {
+	srv := telemetrytest.NewServer()
+	client := New("key", "secret")
+	client.Context.BaseURL = srv.URL
+	return srv, &client
+}
+
+func TestPostMetricsQuery(t *testing.T) {
+	assert := assert.New(t)
+	srv, tc := newTestClient()
+	defer srv.Close()
+
+	srv.Add(telemetrytest.NewPoint(metric.KafkaServerReceivedBytes.Name, testStart, 1, "resource.kafka.id", "lkc-1"))
+
+	q := query.Query{
+		Aggregations: agg.Of(agg.SumOf(metric.KafkaServerReceivedBytes)),
+		Filter:       filter.EqualTo(labels.ResourceKafka, "lkc-1"),
+		Granularity:  granularity.OneHour,
+		Intervals:    interval.Of(interval.StartingFrom(testStart, time.Hour)),
+	}
+	res, err := tc.PostMetricsQuery(q)
+	assert.NoError(err)
+	assert.Len(res.Data, 1)
+	assert.Equal("query", srv.Requests()[0].Endpoint, "Metric queries should be sent to the query endpoint")
+
+	q.Granularity = granularity.Granularity{}
+	_, err = tc.PostMetricsQuery(q)
+	assert.Error(err, "Queries without a valid Granularity should be rejected")
+	assert.Len(srv.Requests(), 1, "Invalid queries shouldn't be sent")
+}
+
+//pagedQuerier serves a page per token, failing once it runs out of pages
+type pagedQuerier struct {
+	pages map[string]response.Query
+}
+
+func (f pagedQuerier) PostMetricsQuery(q query.Query) (response.Query, error) {
+	return f.PostMetricsQueryPage(q, "")
+}
+
+func (f pagedQuerier) PostMetricsQueryPage(q query.Query, pageToken string) (response.Query, error) {
+	if res, ok := f.pages[pageToken]; ok {
+		return res, nil
+	}
+	return response.Query{}, errors.New("page unavailable")
+}
+
+func page(next string, values ...float64) response.Query {
+	res := response.Query{BaseResponse: &response.BaseResponse{Meta: response.Meta{Pagination: response.MetaPagination{NextPageToken: next}}}}
+	for _, v := range values {
+		res.Data = append(res.Data, response.Telemetry{Value: v})
+	}
+	return res
+}
+
+func TestQueryAllPages(t *testing.T) {
+	assert := assert.New(t)
+
+	res, err := QueryAllPages(pagedQuerier{pages: map[string]response.Query{"": page("a", 1, 2), "a": page("b", 3), "b": page("", 4)}}, query.Query{})
+	assert.NoError(err)
+	assert.Len(res.Data, 4, "Every page should be fetched")
+	assert.Equal("", res.NextPageToken())
+
+	res, err = QueryAllPages(pagedQuerier{pages: map[string]response.Query{"": page("a", 1, 2)}}, query.Query{})
+	assert.Error(err, "A failed page should fail the whole query")
+	assert.Len(res.Data, 0, "Partial results shouldn't be returned")
+
+	_, err = QueryAllPages(pagedQuerier{pages: map[string]response.Query{"": page("a", 1), "a": page("a", 2)}}, query.Query{})
+	assert.Error(err, "A repeated page token should fail rather than loop forever")
+}
@@ -3,11 +3,26 @@ package telemetry
 import (
 	"github.com/nerdynick/ccloud-go-sdk/logging"
 	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
 	"go.uber.org/zap"
 )
 
 //PostQuery POST Query to the Telemetry API
-func (client TelemetryClient) PostQuery(response interface{}, url string, q query.Query) error {
+//The query's Filter is normalized first. A Filter that's a contradiction could never match, so the query isn't sent and the response is left empty instead.
+func (client TelemetryClient) PostQuery(res interface{}, url string, q query.Query) error {
+	fil, err := filter.Normalize(q.Filter)
+	if err == filter.ErrContradiction {
+		if r, ok := res.(*response.Query); ok {
+			*r = response.Query{Data: []response.Telemetry{}}
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	q.Filter = fil
+
 	if client.Log.Core().Enabled(logging.InfoLevel) {
 		qJson, _ := q.ToJSON()
 		client.Log.Info("Query - Posting",
@@ -16,10 +31,10 @@ func (client TelemetryClient) PostQuery(response interface{}, url string, q quer
 		)
 	}
 
-	err := q.Validate()
+	err = q.Validate()
 	if err != nil {
 		return err
 	}
 
-	return client.Post(&response, url, q)
+	return client.Post(&res, url, q)
 }
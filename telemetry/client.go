@@ -5,6 +5,7 @@ import (
 
 	jsoniter "github.com/json-iterator/go"
 	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/client/authenticater"
 	"github.com/nerdynick/ccloud-go-sdk/client/response"
 )
 
@@ -12,9 +13,11 @@ const (
 	//DefaultQueryLimit the default query limit for results
 	DefaultQueryLimit int = 1000
 	//DefaultBaseURL is the default Domain and Protocol for quering against the Metrics API
-	DefaultBaseURL string = "https://api.telemetry.confluent.cloud"
+	DefaultBaseURL string = client.DefaultTelemetryBaseURL
 	//DefaultMaxWorkers controls the max number of workers in a given Worker Pool that will be spawned
 	DefaultMaxWorkers int = 5
+	//DefaultMaxResourcesPerQuery controls the max number of resource IDs that will be OR'ed together into a single query's filter
+	DefaultMaxResourcesPerQuery int = 25
 
 	//DatasetCloud constant name for the CCloud dataset
 	DatasetCloud  Dataset = "cloud"
@@ -41,21 +44,25 @@ type Dataset string
 //TelemetryClient is the SDK Client for making REST calls to the Confluent Metrics API
 type TelemetryClient struct {
 	client.Client
-	PageLimit  int
-	DataSet    Dataset
-	MaxWorkers int
+	PageLimit            int
+	DataSet              Dataset
+	MaxWorkers           int
+	MaxResourcesPerQuery int
 }
 
 //New Used to create a new MetricsClient from the given minimal set of properties
 func New(apiKey string, apiSecret string) TelemetryClient {
-	return TelemetryClient{
-		DataSet:    DatasetCloud,
-		PageLimit:  DefaultQueryLimit,
-		MaxWorkers: DefaultMaxWorkers,
-		Client: client.New(apiKey, apiSecret, DefaultBaseURL, func(statusCode int, body []byte) error {
+	c := TelemetryClient{
+		DataSet:              DatasetCloud,
+		PageLimit:            DefaultQueryLimit,
+		MaxWorkers:           DefaultMaxWorkers,
+		MaxResourcesPerQuery: DefaultMaxResourcesPerQuery,
+		Client: client.New(authenticater.NewAPIKeyAuth(apiKey, apiSecret), DefaultBaseURL, func(statusCode int, body []byte) error {
 			err := response.ErrorResponse{}
 			json.Unmarshal(body, &err)
 			return err
 		}),
 	}
+	c.Context.Endpoint = client.Endpoint{Service: client.ServiceTelemetry}
+	return c
 }
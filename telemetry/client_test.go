@@ -3,6 +3,7 @@ package telemetry
 import (
 	"testing"
 
+	"github.com/nerdynick/ccloud-go-sdk/client/authenticater"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,10 +12,13 @@ func TestNew(t *testing.T) {
 
 	apiClient := New("apikey", "apisec")
 
-	assert.Equal("apikey", apiClient.Context.APIKey)
-	assert.Equal("apisec", apiClient.Context.APISecret)
+	auth, ok := apiClient.Authorizer.(authenticater.APIKeyAuthenticater)
+	assert.True(ok)
+	assert.Equal("apikey", auth.APIKey)
+	assert.Equal("apisec", auth.APISecret.Value())
 	assert.Equal(DefaultBaseURL, apiClient.Context.BaseURL)
 	assert.Equal(DefaultQueryLimit, apiClient.PageLimit)
 	assert.Equal(DatasetCloud, apiClient.DataSet)
 	assert.Equal(DefaultMaxWorkers, apiClient.MaxWorkers)
+	assert.Equal(DefaultMaxResourcesPerQuery, apiClient.MaxResourcesPerQuery)
 }
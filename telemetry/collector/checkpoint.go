@@ -0,0 +1,121 @@
+package collector
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+//CheckpointStore persists the watermark, the end of the last delivered bucket, of each source. It's the building block Sinks keep their watermarks in
+type CheckpointStore interface {
+	Load(source string) (time.Time, bool, error)
+	Save(source string, watermark time.Time) error
+}
+
+//MemoryCheckpointStore is an in memory CheckpointStore. Watermarks are lost on restart, so it is mostly useful for testing.
+type MemoryCheckpointStore struct {
+	mu         sync.Mutex
+	watermarks map[string]time.Time
+}
+
+//NewMemoryCheckpointStore creates a new empty MemoryCheckpointStore
+func NewMemoryCheckpointStore() *MemoryCheckpointStore {
+	return &MemoryCheckpointStore{
+		watermarks: map[string]time.Time{},
+	}
+}
+
+func (s *MemoryCheckpointStore) Load(source string) (time.Time, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	watermark, ok := s.watermarks[source]
+	return watermark, ok, nil
+}
+
+func (s *MemoryCheckpointStore) Save(source string, watermark time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.watermarks[source] = watermark
+	return nil
+}
+
+//FileCheckpointStore is a CheckpointStore that keeps the watermarks of all sources in a single JSON file.
+//The file is replaced atomically on every save, so a crash never leaves a partially written checkpoint behind.
+type FileCheckpointStore struct {
+	Path string
+	mu   sync.Mutex
+}
+
+//NewFileCheckpointStore creates a new FileCheckpointStore backed by the file at the given path
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{
+		Path: path,
+	}
+}
+
+func (s *FileCheckpointStore) Load(source string) (time.Time, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	watermarks, err := s.read()
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	watermark, ok := watermarks[source]
+	return watermark, ok, nil
+}
+
+func (s *FileCheckpointStore) Save(source string, watermark time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	watermarks, err := s.read()
+	if err != nil {
+		return err
+	}
+	watermarks[source] = watermark
+
+	js, err := json.MarshalIndent(watermarks, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(s.Path, js)
+}
+
+//writeFileAtomic writes the data to a temp file next to the path before renaming it over the path, so a crash never leaves a partially written file behind
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *FileCheckpointStore) read() (map[string]time.Time, error) {
+	watermarks := map[string]time.Time{}
+
+	js, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return watermarks, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(js, &watermarks)
+	return watermarks, err
+}
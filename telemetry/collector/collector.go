@@ -0,0 +1,220 @@
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/logging"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+	"go.uber.org/zap"
+)
+
+const (
+	//DefaultSettleDelay is the default time to wait after a bucket closes before it is collected, giving the API time to finish revising it
+	DefaultSettleDelay time.Duration = 5 * time.Minute
+	//DefaultPollInterval is the default time between each collection of all the sources
+	DefaultPollInterval time.Duration = time.Minute
+	//DefaultMaxBucketsPerQuery is the default max number of buckets fetched by a single query while catching up
+	DefaultMaxBucketsPerQuery int = 60
+	//DefaultPageLimit is the query limit used for sources that don't set one. Every page is fetched regardless
+	DefaultPageLimit int = telemetry.DefaultQueryLimit
+)
+
+//Source is a named Metric Query to collect. The Intervals and Granularity of the Query are set by the Collector.
+type Source struct {
+	Name  string
+	Query query.Query
+}
+
+//Bucket is a single closed, granularity aligned, window of time for a Source
+type Bucket struct {
+	Source      string                  `json:"source"`
+	Start       time.Time               `json:"start"`
+	End         time.Time               `json:"end"`
+	Granularity granularity.Granularity `json:"granularity"`
+	Data        []response.Telemetry    `json:"data"`
+}
+
+//Sink receives each closed Bucket, and keeps the watermark, the end of the last delivered bucket, of each Source.
+//Buckets are delivered complete and in order per Source, and delivery is retried until the Sink accepts it.
+//
+//Deliver must commit the bucket's data together with its End as the Source's new watermark, such as within a single database transaction,
+//so that one is never saved without the other. Watermark returns the last watermark committed for a Source, and false when there is none yet.
+type Sink interface {
+	Deliver(bucket Bucket) error
+	Watermark(source string) (time.Time, bool, error)
+}
+
+//Collector repeatedly queries its sources in consecutive granularity aligned windows, delivering every closed bucket to the Sink.
+//
+//A bucket is only collected once SettleDelay has passed since it closed, as the API keeps revising recent data for a few minutes.
+//Every page of a window is fetched before any of its buckets are delivered, so a bucket is never delivered with missing data,
+//and a failed page leaves the watermark where it was to be retried on the next collection.
+//
+//Each source resumes from the watermark the Sink committed along with its last delivered bucket, so every bucket is delivered exactly once,
+//even when the process dies part way through a delivery and is restarted.
+type Collector struct {
+	*logging.Loggable
+	Client             telemetry.PagedMetricQuerier
+	Sink               Sink
+	Sources            []Source
+	Granularity        granularity.Granularity
+	SettleDelay        time.Duration
+	PollInterval       time.Duration
+	MaxBucketsPerQuery int
+	//Backfill is how far back to start collecting a source that has no checkpoint yet. Defaults to a single bucket.
+	Backfill time.Duration
+}
+
+//New creates a new Collector of the given sources
+func New(client telemetry.PagedMetricQuerier, sink Sink, gran granularity.Granularity, sources ...Source) *Collector {
+	return &Collector{
+		Loggable:           logging.New("TelemetryCollector"),
+		Client:             client,
+		Sink:               sink,
+		Sources:            sources,
+		Granularity:        gran,
+		SettleDelay:        DefaultSettleDelay,
+		PollInterval:       DefaultPollInterval,
+		MaxBucketsPerQuery: DefaultMaxBucketsPerQuery,
+	}
+}
+
+//Run collects all the sources every PollInterval until the context is cancelled
+func (c *Collector) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Collect(ctx, time.Now()); err != nil {
+			c.Log.Error("Collector - Collection failed", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+//Collect collects every closed bucket, as of the given time, of all the sources
+func (c *Collector) Collect(ctx context.Context, now time.Time) error {
+	var lastErr error
+	for _, src := range c.Sources {
+		if err := c.CollectSource(ctx, src, now); err != nil {
+			c.Log.Error("Collector - Source collection failed",
+				zap.String("source", src.Name),
+				zap.Error(err),
+			)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+//CollectSource collects every closed bucket, as of the given time, of a single source
+func (c *Collector) CollectSource(ctx context.Context, src Source, now time.Time) error {
+	step := c.Granularity.Duration
+	if !c.Granularity.IsValid() || c.Granularity.Equals(granularity.All) {
+		return fmt.Errorf("Collector requires a valid, none ALL, Granularity. Got `%s`", c.Granularity)
+	}
+	if src.Name == "" {
+		return errors.New("Sources require a Name to checkpoint by")
+	}
+
+	closed := now.Add(-c.SettleDelay).Truncate(step)
+
+	watermark, ok, err := c.Sink.Watermark(src.Name)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		backfill := c.Backfill
+		if backfill < step {
+			backfill = step
+		}
+		watermark = closed.Add(-backfill)
+	}
+	watermark = watermark.Truncate(step)
+
+	for watermark.Before(closed) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		end := watermark.Add(c.maxWindow())
+		if end.After(closed) {
+			end = closed
+		}
+
+		q := src.Query
+		q.Intervals = interval.Of(interval.Between(watermark, end))
+		q.Granularity = c.Granularity
+		if q.Limit <= 0 {
+			q.Limit = DefaultPageLimit
+		}
+
+		c.Log.Debug("Collector - Querying window",
+			zap.String("source", src.Name),
+			zap.Time("start", watermark),
+			zap.Time("end", end),
+		)
+		res, err := telemetry.QueryAllPages(c.Client, q)
+		if err != nil {
+			return err
+		}
+
+		for _, bucket := range bucketsOf(src.Name, c.Granularity, watermark, end, res.Data) {
+			if err := c.Sink.Deliver(bucket); err != nil {
+				return err
+			}
+			watermark = bucket.End
+		}
+	}
+
+	return nil
+}
+
+//maxWindow is the largest window of time, in whole buckets, a single query may cover
+func (c *Collector) maxWindow() time.Duration {
+	buckets := c.MaxBucketsPerQuery
+	if buckets <= 0 {
+		buckets = DefaultMaxBucketsPerQuery
+	}
+
+	step := c.Granularity.Duration
+	window := step * time.Duration(buckets)
+	if window/time.Duration(buckets) != step || window > c.Granularity.MaxDuration {
+		window = c.Granularity.MaxDuration.Truncate(step)
+	}
+	return window
+}
+
+//bucketsOf splits the data points of a window into each of its buckets, including any empty buckets
+func bucketsOf(source string, gran granularity.Granularity, start time.Time, end time.Time, data []response.Telemetry) []Bucket {
+	buckets := []Bucket{}
+	for t := start; t.Before(end); t = t.Add(gran.Duration) {
+		buckets = append(buckets, Bucket{
+			Source:      source,
+			Start:       t,
+			End:         t.Add(gran.Duration),
+			Granularity: gran,
+		})
+	}
+
+	for _, d := range data {
+		i := int(d.Timestamp.Sub(start) / gran.Duration)
+		if d.Timestamp.Before(start) || i >= len(buckets) {
+			continue
+		}
+		buckets[i].Data = append(buckets[i].Data, d)
+	}
+	return buckets
+}
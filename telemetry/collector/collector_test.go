@@ -0,0 +1,227 @@
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+	"github.com/stretchr/testify/assert"
+)
+
+//minuteQuerier returns a single point per minute of the queried interval, valued at the minute of the hour.
+//With a pageSize the points are split into pages, and failPage fails the page at that offset.
+type minuteQuerier struct {
+	queries  int
+	pageSize int
+	failPage string
+}
+
+func (f *minuteQuerier) PostMetricsQuery(q query.Query) (response.Query, error) {
+	return f.PostMetricsQueryPage(q, "")
+}
+
+func (f *minuteQuerier) PostMetricsQueryPage(q query.Query, pageToken string) (response.Query, error) {
+	if pageToken == "" {
+		f.queries++
+	}
+	if f.failPage != "" && pageToken == f.failPage {
+		return response.Query{}, errors.New("page unavailable")
+	}
+
+	data := []response.Telemetry{}
+	for t := q.Intervals[0].Start(); t.Before(q.Intervals[0].End()); t = t.Add(time.Minute) {
+		data = append(data, response.Telemetry{Timestamp: t, Value: float64(t.Minute())})
+	}
+	if f.pageSize <= 0 {
+		return response.Query{Data: data}, nil
+	}
+
+	offset, _ := strconv.Atoi(pageToken)
+	end := offset + f.pageSize
+	res := response.Query{BaseResponse: &response.BaseResponse{}}
+	if end < len(data) {
+		res.Meta.Pagination.NextPageToken = strconv.Itoa(end)
+	} else {
+		end = len(data)
+	}
+	res.Data = data[offset:end]
+	return res, nil
+}
+
+//recordingSink is a MemorySink that can be made to fail
+type recordingSink struct {
+	*MemorySink
+	fail bool
+}
+
+func newRecordingSink() *recordingSink {
+	return &recordingSink{MemorySink: NewMemorySink()}
+}
+
+func (s *recordingSink) Deliver(bucket Bucket) error {
+	if s.fail {
+		return errors.New("sink unavailable")
+	}
+	return s.MemorySink.Deliver(bucket)
+}
+
+func source() Source {
+	return Source{
+		Name: "received_bytes",
+		Query: query.Query{
+			Aggregations: agg.Of(agg.SumOf(metric.KafkaServerReceivedBytes)),
+		},
+	}
+}
+
+func TestCollector_CollectSource(t *testing.T) {
+	assert := assert.New(t)
+
+	client := &minuteQuerier{}
+	sink := newRecordingSink()
+	c := New(client, sink, granularity.OneMin, source())
+	c.Backfill = 3 * time.Minute
+
+	now := time.Date(2021, 1, 1, 10, 10, 30, 0, time.UTC)
+	assert.Nil(c.Collect(context.Background(), now))
+	assert.Len(sink.Buckets, 3, "Expected the backfilled buckets before the settle delay")
+	assert.Equal(time.Date(2021, 1, 1, 10, 2, 0, 0, time.UTC), sink.Buckets[0].Start)
+	assert.Equal(time.Date(2021, 1, 1, 10, 5, 0, 0, time.UTC), sink.Buckets[2].End)
+	assert.Len(sink.Buckets[1].Data, 1)
+	assert.Equal(3.0, sink.Buckets[1].Data[0].Value)
+
+	assert.Nil(c.Collect(context.Background(), now))
+	assert.Len(sink.Buckets, 3, "No new buckets have closed, so nothing should be delivered")
+
+	assert.Nil(c.Collect(context.Background(), now.Add(2*time.Minute)))
+	assert.Len(sink.Buckets, 5)
+	assert.Equal(time.Date(2021, 1, 1, 10, 5, 0, 0, time.UTC), sink.Buckets[3].Start, "Buckets should be consecutive")
+
+	watermark, ok, _ := sink.Watermark("received_bytes")
+	assert.True(ok)
+	assert.Equal(time.Date(2021, 1, 1, 10, 7, 0, 0, time.UTC), watermark)
+}
+
+func TestCollector_SinkFailure(t *testing.T) {
+	assert := assert.New(t)
+
+	sink := newRecordingSink()
+	sink.fail = true
+	c := New(&minuteQuerier{}, sink, granularity.OneMin, source())
+
+	now := time.Date(2021, 1, 1, 10, 10, 0, 0, time.UTC)
+	assert.NotNil(c.Collect(context.Background(), now))
+	_, ok, _ := sink.Watermark("received_bytes")
+	assert.False(ok, "Watermark shouldn't move when delivery fails")
+
+	sink.fail = false
+	assert.Nil(c.Collect(context.Background(), now))
+	assert.Len(sink.Buckets, 1, "Failed bucket should be delivered on retry")
+	assert.Equal(time.Date(2021, 1, 1, 10, 4, 0, 0, time.UTC), sink.Buckets[0].Start)
+}
+
+func TestCollector_ResumeFromFile(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "collector")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+
+	now := time.Date(2021, 1, 1, 10, 10, 0, 0, time.UTC)
+	first := NewFileSink(dir)
+	c := New(&minuteQuerier{}, first, granularity.OneMin, source())
+	assert.Nil(c.Collect(context.Background(), now))
+	watermark, ok, err := first.Watermark("received_bytes")
+	assert.NoError(err)
+	assert.True(ok)
+
+	//Simulate a restart with a brand new Collector and Sink over the same directory
+	second := NewFileSink(dir)
+	client := &minuteQuerier{}
+	c = New(client, second, granularity.OneMin, source())
+	assert.Nil(c.Collect(context.Background(), now.Add(30*time.Minute)))
+	assert.Equal(1, client.queries, "30 buckets should fit within a single query")
+
+	files, err := filepath.Glob(filepath.Join(dir, "received_bytes", "*.json"))
+	assert.NoError(err)
+	assert.Len(files, 31, "Each bucket should be delivered once")
+
+	js, err := ioutil.ReadFile(filepath.Join(dir, "received_bytes", watermark.Format(BucketTimeFormat)+".json"))
+	assert.NoError(err, "Collection should resume at the watermark")
+	bucket := struct {
+		Start time.Time            `json:"start"`
+		Data  []response.Telemetry `json:"data"`
+	}{}
+	assert.NoError(json.Unmarshal(js, &bucket))
+	assert.Equal(watermark, bucket.Start.UTC())
+	assert.Len(bucket.Data, 1)
+}
+
+func TestFileSink_CrashBeforeWatermark(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "collector")
+	assert.Nil(err)
+	defer os.RemoveAll(dir)
+
+	now := time.Date(2021, 1, 1, 10, 10, 0, 0, time.UTC)
+	sink := NewFileSink(dir)
+	c := New(&minuteQuerier{}, sink, granularity.OneMin, source())
+	c.Backfill = 2 * time.Minute
+	assert.Nil(c.Collect(context.Background(), now))
+
+	//Simulate dying after writing the last bucket, but before committing its watermark
+	assert.NoError(sink.Checkpoints.Save("received_bytes", time.Date(2021, 1, 1, 10, 4, 0, 0, time.UTC)))
+
+	sink = NewFileSink(dir)
+	c = New(&minuteQuerier{}, sink, granularity.OneMin, source())
+	assert.Nil(c.Collect(context.Background(), now))
+	files, err := filepath.Glob(filepath.Join(dir, "received_bytes", "*.json"))
+	assert.NoError(err)
+	assert.Len(files, 2, "The redelivered bucket should replace its own file")
+	watermark, _, _ := sink.Watermark("received_bytes")
+	assert.Equal(time.Date(2021, 1, 1, 10, 5, 0, 0, time.UTC), watermark)
+}
+
+func TestCollector_Pagination(t *testing.T) {
+	assert := assert.New(t)
+
+	client := &minuteQuerier{pageSize: 2, failPage: "4"}
+	sink := newRecordingSink()
+	c := New(client, sink, granularity.OneMin, source())
+	c.Backfill = 5 * time.Minute
+
+	now := time.Date(2021, 1, 1, 10, 10, 0, 0, time.UTC)
+	assert.NotNil(c.Collect(context.Background(), now), "A failed page should fail the collection")
+	assert.Len(sink.Buckets, 0, "No bucket of a window should be delivered until every page is fetched")
+	_, ok, _ := sink.Watermark("received_bytes")
+	assert.False(ok, "Watermark shouldn't move when a page fails")
+
+	client.failPage = ""
+	assert.Nil(c.Collect(context.Background(), now))
+	assert.Len(sink.Buckets, 5)
+	for _, b := range sink.Buckets {
+		assert.Len(b.Data, 1, "Bucket %s should have the data of every page", b.Start)
+	}
+}
+
+func TestCollector_MaxWindow(t *testing.T) {
+	assert := assert.New(t)
+
+	c := New(&minuteQuerier{}, NewMemorySink(), granularity.OneMin)
+	assert.Equal(60*time.Minute, c.maxWindow())
+
+	c.MaxBucketsPerQuery = 1000
+	assert.Equal(6*time.Hour, c.maxWindow(), "Window should be capped by the granularity's max duration")
+}
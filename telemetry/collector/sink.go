@@ -0,0 +1,81 @@
+package collector
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+//BucketTimeFormat is the format of the Start of a bucket within the name of its file
+const BucketTimeFormat string = "20060102T150405Z"
+
+var pathEscaper = strings.NewReplacer("/", "_", "\\", "_", ":", "_")
+
+//MemorySink is an in memory Sink, keeping every delivered bucket. Buckets and watermarks are lost on restart, so it is mostly useful for testing.
+type MemorySink struct {
+	mu          sync.Mutex
+	Buckets     []Bucket
+	checkpoints *MemoryCheckpointStore
+}
+
+//NewMemorySink creates a new empty MemorySink
+func NewMemorySink() *MemorySink {
+	return &MemorySink{
+		checkpoints: NewMemoryCheckpointStore(),
+	}
+}
+
+func (s *MemorySink) Deliver(bucket Bucket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Buckets = append(s.Buckets, bucket)
+	return s.checkpoints.Save(bucket.Source, bucket.End)
+}
+
+func (s *MemorySink) Watermark(source string) (time.Time, bool, error) {
+	return s.checkpoints.Load(source)
+}
+
+//FileSink is a Sink that writes each bucket to its own JSON file, named by its Source and Start, keeping the watermarks in a FileCheckpointStore within the same directory.
+//A bucket's file is replaced atomically before its watermark is saved, so should the process die in between, the bucket delivered again on restart replaces its own file rather than adding a second copy.
+type FileSink struct {
+	Dir         string
+	Checkpoints *FileCheckpointStore
+}
+
+//NewFileSink creates a new FileSink writing to the given directory
+func NewFileSink(dir string) *FileSink {
+	return &FileSink{
+		Dir:         dir,
+		Checkpoints: NewFileCheckpointStore(filepath.Join(dir, "_watermarks.json")),
+	}
+}
+
+func (s *FileSink) Deliver(bucket Bucket) error {
+	js, err := json.Marshal(bucket)
+	if err != nil {
+		return err
+	}
+
+	path := s.Path(bucket)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if err := writeFileAtomic(path, js); err != nil {
+		return err
+	}
+	return s.Checkpoints.Save(bucket.Source, bucket.End)
+}
+
+func (s *FileSink) Watermark(source string) (time.Time, bool, error) {
+	return s.Checkpoints.Load(source)
+}
+
+//Path returns the path of the file a bucket is written to
+func (s *FileSink) Path(bucket Bucket) string {
+	return filepath.Join(s.Dir, pathEscaper.Replace(bucket.Source), bucket.Start.UTC().Format(BucketTimeFormat)+".json")
+}
@@ -1,7 +1,40 @@
 package labels
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 //Label represents a returned Resource Type label from the API
 type Label interface {
 	MarshalJSON() ([]byte, error)
 	String() string
 }
+
+//Key returns the full field key of a Label, as used by the API in filters and results. Such as `resource.kafka.id` or `metric.topic`
+func Key(l Label) string {
+	js, err := l.MarshalJSON()
+	if err != nil {
+		return l.String()
+	}
+
+	var key string
+	if err := json.Unmarshal(js, &key); err != nil {
+		return l.String()
+	}
+	return key
+}
+
+//FromKey creates a Label from its full field key. Keys prefixed with `resource.` become Resource labels, reusing the known ones where possible, and all others become Metric labels.
+func FromKey(key string) Label {
+	if !strings.HasPrefix(key, "resource.") {
+		return NewMetric(key)
+	}
+
+	for _, r := range KnownResources {
+		if Key(r) == key {
+			return r
+		}
+	}
+	return newResource(strings.TrimPrefix(key, "resource."))
+}
@@ -9,12 +9,18 @@ var (
 	MetricType Metric = NewMetric("metric.type")
 	//MetricPartition is a static def for the Partition Label
 	MetricPartition Metric = NewMetric("metric.partition")
+	//MetricConsumerGroupID is a static def for the Consumer Group ID Label
+	MetricConsumerGroupID Metric = NewMetric("metric.consumer_group_id")
+	//MetricPrincipalID is a static def for the Principal ID Label, such as a User or Service Account ID
+	MetricPrincipalID Metric = NewMetric("metric.principal_id")
 
 	//KnownMetrics is a collection of all the available MetricLabels
 	KnownMetrics []Metric = []Metric{
 		MetricTopic,
 		MetricType,
 		MetricPartition,
+		MetricConsumerGroupID,
+		MetricPrincipalID,
 	}
 )
 
@@ -0,0 +1,52 @@
+package metric
+
+import "strings"
+
+const (
+	//KindUnknown is used when the kind of a metric couldn't be determined
+	KindUnknown Kind = ""
+	//KindGauge is a static def for metrics that report an instantaneous value
+	KindGauge Kind = "GAUGE"
+	//KindDelta is a static def for metrics that report the change over each interval
+	KindDelta Kind = "DELTA"
+	//KindCounter is a static def for metrics that report a count of events over each interval
+	KindCounter Kind = "COUNTER"
+)
+
+var (
+	//KnownKinds is a collection of all the known metric kinds
+	KnownKinds []Kind = []Kind{
+		KindGauge,
+		KindDelta,
+		KindCounter,
+	}
+)
+
+//Kind represents how the values of a metric are reported
+type Kind string
+
+//IsDelta checks if the values of the metric represent a change over the reported interval.
+//The Telemetry API reports counters as the count within each interval, so they are treated as deltas.
+func (k Kind) IsDelta() bool {
+	return k == KindDelta || k == KindCounter
+}
+
+//IsGauge checks if the values of the metric represent a point in time value
+func (k Kind) IsGauge() bool {
+	return k == KindGauge
+}
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+//ParseKind parses a descriptor type, such as `GAUGE_INT64` or `COUNTER_DOUBLE`, into its Kind
+func ParseKind(t string) Kind {
+	t = strings.ToUpper(t)
+	for _, k := range KnownKinds {
+		if t == string(k) || strings.HasPrefix(t, string(k)+"_") {
+			return k
+		}
+	}
+	return KindUnknown
+}
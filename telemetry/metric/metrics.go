@@ -3,6 +3,7 @@ package metric
 import (
 	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
 )
@@ -12,25 +13,28 @@ const (
 )
 
 var (
-	KafkaServerReceivedBytes     = New("io.confluent.kafka.server/received_bytes", labels.MetricTopic, labels.MetricPartition)
-	KafkaServerSentBytes         = New("io.confluent.kafka.server/sent_bytes", labels.MetricTopic, labels.MetricPartition)
-	KafkaServerReceivedRecords   = New("io.confluent.kafka.server/received_records", labels.MetricTopic, labels.MetricPartition)
-	KafkaServerSentRecords       = New("io.confluent.kafka.server/sent_records", labels.MetricTopic, labels.MetricPartition)
-	KafkaServerRetainedBytes     = New("io.confluent.kafka.server/retained_bytes", labels.MetricTopic, labels.MetricPartition)
-	KafkaServerActiveConnections = New("io.confluent.kafka.server/active_connection_count")
-	KafkaServerRequests          = New("io.confluent.kafka.server/request_count", labels.MetricType)
-	KafkaServerPartition         = New("io.confluent.kafka.server/partition_count")
-	KafkaServerSuccessAuth       = New("io.confluent.kafka.server/successful_authentication_count")
-
-	KSQLStreamingUnitCount = New("io.confluent.kafka.ksql/streaming_unit_count")
-
-	SchemaRegSchemaCount = New("io.confluent.kafka.schema_registry/schema_count")
-
-	ConnectorSentRecords            = New("io.confluent.kafka.connect/sent_records")
-	ConnectorReceivedRecords        = New("io.confluent.kafka.connect/received_records")
-	ConnectorSentBytes              = New("io.confluent.kafka.connect/sent_bytes")
-	ConnectorReceivedBytes          = New("io.confluent.kafka.connect/received_bytes")
-	ConnectorDeadLetterQueueRecords = New("io.confluent.kafka.connect/dead_letter_queue_records")
+	KafkaServerReceivedBytes     = NewTyped("io.confluent.kafka.server/received_bytes", KindDelta, UnitBytes, labels.MetricTopic, labels.MetricPartition)
+	KafkaServerSentBytes         = NewTyped("io.confluent.kafka.server/sent_bytes", KindDelta, UnitBytes, labels.MetricTopic, labels.MetricPartition)
+	KafkaServerReceivedRecords   = NewTyped("io.confluent.kafka.server/received_records", KindDelta, UnitRecords, labels.MetricTopic, labels.MetricPartition)
+	KafkaServerSentRecords       = NewTyped("io.confluent.kafka.server/sent_records", KindDelta, UnitRecords, labels.MetricTopic, labels.MetricPartition)
+	KafkaServerRetainedBytes     = NewTyped("io.confluent.kafka.server/retained_bytes", KindGauge, UnitBytes, labels.MetricTopic, labels.MetricPartition)
+	KafkaServerActiveConnections = NewTyped("io.confluent.kafka.server/active_connection_count", KindGauge, UnitConnections)
+	KafkaServerRequests          = NewTyped("io.confluent.kafka.server/request_count", KindDelta, UnitRequests, labels.MetricType, labels.MetricPrincipalID)
+	KafkaServerRequestBytes      = NewTyped("io.confluent.kafka.server/request_bytes", KindDelta, UnitBytes, labels.MetricType, labels.MetricPrincipalID)
+	KafkaServerResponseBytes     = NewTyped("io.confluent.kafka.server/response_bytes", KindDelta, UnitBytes, labels.MetricType, labels.MetricPrincipalID)
+	KafkaServerPartition         = NewTyped("io.confluent.kafka.server/partition_count", KindGauge, UnitCount)
+	KafkaServerSuccessAuth       = NewTyped("io.confluent.kafka.server/successful_authentication_count", KindDelta, UnitCount)
+	KafkaServerConsumerLag       = NewTyped("io.confluent.kafka.server/consumer_lag_offsets", KindGauge, UnitOffsets, labels.MetricConsumerGroupID, labels.MetricTopic, labels.MetricPartition)
+
+	KSQLStreamingUnitCount = NewTyped("io.confluent.kafka.ksql/streaming_unit_count", KindGauge, UnitCount)
+
+	SchemaRegSchemaCount = NewTyped("io.confluent.kafka.schema_registry/schema_count", KindGauge, UnitCount)
+
+	ConnectorSentRecords            = NewTyped("io.confluent.kafka.connect/sent_records", KindDelta, UnitRecords)
+	ConnectorReceivedRecords        = NewTyped("io.confluent.kafka.connect/received_records", KindDelta, UnitRecords)
+	ConnectorSentBytes              = NewTyped("io.confluent.kafka.connect/sent_bytes", KindDelta, UnitBytes)
+	ConnectorReceivedBytes          = NewTyped("io.confluent.kafka.connect/received_bytes", KindDelta, UnitBytes)
+	ConnectorDeadLetterQueueRecords = NewTyped("io.confluent.kafka.connect/dead_letter_queue_records", KindDelta, UnitRecords)
 
 	KnownKafkaServerMetrics = []Metric{
 		KafkaServerReceivedBytes,
@@ -40,8 +44,11 @@ var (
 		KafkaServerRetainedBytes,
 		KafkaServerActiveConnections,
 		KafkaServerRequests,
+		KafkaServerRequestBytes,
+		KafkaServerResponseBytes,
 		KafkaServerPartition,
 		KafkaServerSuccessAuth,
+		KafkaServerConsumerLag,
 	}
 
 	KnownKSQLMetrics = []Metric{
@@ -66,6 +73,7 @@ type Metric struct {
 	Name           string          `json:"name" cjson:"name"`
 	Desc           string          `json:"description,omitempty" cjson:"description,omitempty"`
 	Type           string          `json:"type,omitempty" cjson:"type,omitempty"`
+	Unit           Unit            `json:"unit,omitempty" cjson:"unit,omitempty"`
 	LifecycleStage string          `json:"lifecycle_stage,omitempty" cjson:"lifecycle_stage,omitempty"`
 	Labels         []labels.Metric `json:"labels,omitempty" cjson:"labels,omitempty"`
 }
@@ -74,6 +82,16 @@ func (m Metric) MarshalJSON() ([]byte, error) {
 	return json.Marshal(m.Name)
 }
 
+//HasLabel checks if the metric is known to support a given label
+func (m Metric) HasLabel(label labels.Metric) bool {
+	for _, l := range m.Labels {
+		if l.Equals(label) {
+			return true
+		}
+	}
+	return false
+}
+
 //Matches check if a given metric name is equal to this metric
 func (m Metric) Matches(name string) bool {
 	return m.Name == name || m.ShortName() == name
@@ -84,6 +102,68 @@ func (m Metric) ShortName() string {
 	return strings.TrimPrefix(m.Name, metricPrefix)
 }
 
+//Kind returns the Kind of the metric, as determined by its descriptor type or the known metrics
+func (m Metric) Kind() Kind {
+	return ParseKind(m.Describe().Type)
+}
+
+//Describe returns a copy of the metric with any missing type and unit details filled in from the known metrics
+func (m Metric) Describe() Metric {
+	if m.Type != "" && m.Unit != UnitUnknown {
+		return m
+	}
+	known, ok := Lookup(m.Name)
+	if !ok {
+		return m
+	}
+	if m.Type == "" {
+		m.Type = known.Type
+	}
+	if m.Unit == UnitUnknown {
+		m.Unit = known.Unit
+	}
+	return m
+}
+
+//FormatValue returns a human readable representation of a value of this metric, such as `1.5 MiB`
+func (m Metric) FormatValue(value float64) string {
+	return m.Describe().Unit.Format(value)
+}
+
+//FormatRate returns a human readable representation of a per second rate of this metric, such as `1.5 MiB/s`
+func (m Metric) FormatRate(value float64) string {
+	return m.Describe().Unit.FormatRate(value)
+}
+
+//Rate converts a value reported over the given duration into a per second rate.
+//Rates only make sense for delta metrics, so false is returned for any other Kind.
+func (m Metric) Rate(value float64, over time.Duration) (float64, bool) {
+	if !m.Kind().IsDelta() || over <= 0 {
+		return 0, false
+	}
+	return value / over.Seconds(), true
+}
+
+//Lookup finds a known metric by its full or short name
+func Lookup(name string) (Metric, bool) {
+	for _, known := range [][]Metric{KnownKafkaServerMetrics, KnownKSQLMetrics, KnownSchemaRegMetrics, KnownConnectorMetrics} {
+		for _, m := range known {
+			if m.Matches(name) {
+				return m, true
+			}
+		}
+	}
+	return Metric{}, false
+}
+
+//NewTyped creates a new Metric of a known Kind and Unit
+func NewTyped(name string, kind Kind, unit Unit, labels ...labels.Metric) Metric {
+	m := New(name, labels...)
+	m.Type = string(kind)
+	m.Unit = unit
+	return m
+}
+
 func New(name string, labels ...labels.Metric) Metric {
 	if strings.HasPrefix(name, metricPrefix) {
 		return Metric{Name: name, Labels: labels}
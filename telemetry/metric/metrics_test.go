@@ -0,0 +1,62 @@
+package metric
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseKind(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(KindGauge, ParseKind("GAUGE_INT64"), "Kind doesn't match expected")
+	assert.Equal(KindCounter, ParseKind("COUNTER_DOUBLE"), "Kind doesn't match expected")
+	assert.Equal(KindDelta, ParseKind("DELTA"), "Kind doesn't match expected")
+	assert.Equal(KindUnknown, ParseKind("GAUGEINT64"), "Kind doesn't match expected")
+	assert.Equal(KindUnknown, ParseKind(""), "Kind doesn't match expected")
+
+	assert.True(KindCounter.IsDelta())
+	assert.True(KindDelta.IsDelta())
+	assert.False(KindGauge.IsDelta())
+}
+
+func TestUnit_Format(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("512 B", UnitBytes.Format(512))
+	assert.Equal("1.5 KiB", UnitBytes.Format(1536))
+	assert.Equal("2 MiB/s", UnitBytes.FormatRate(2*1024*1024))
+	assert.Equal("12.35k rec/s", UnitRecords.FormatRate(12345))
+	assert.Equal("42 conn", UnitConnections.Format(42))
+	assert.Equal("87.5%", UnitPercent.Format(87.5))
+	assert.Equal("1.2M", UnitCount.Format(1200000))
+	assert.Equal("3/s", UnitUnknown.FormatRate(3))
+}
+
+func TestMetric_Describe(t *testing.T) {
+	assert := assert.New(t)
+
+	m := New("received_bytes")
+	assert.Equal(KindDelta, m.Kind(), "Kind wasn't resolved from the known metrics")
+	assert.Equal(UnitBytes, m.Describe().Unit, "Unit wasn't resolved from the known metrics")
+
+	m = Metric{Name: "io.confluent.kafka.server/retained_bytes", Type: "GAUGE_INT64"}
+	assert.Equal(KindGauge, m.Kind(), "Descriptor type should be used")
+	assert.Equal(UnitBytes, m.Describe().Unit, "Unit wasn't resolved from the known metrics")
+
+	m = New("unknown_metric")
+	assert.Equal(KindUnknown, m.Kind())
+	assert.Equal(UnitUnknown, m.Describe().Unit)
+}
+
+func TestMetric_Rate(t *testing.T) {
+	assert := assert.New(t)
+
+	r, ok := KafkaServerReceivedBytes.Rate(600, time.Minute)
+	assert.True(ok)
+	assert.Equal(10.0, r)
+
+	_, ok = KafkaServerRetainedBytes.Rate(600, time.Minute)
+	assert.False(ok, "Gauges shouldn't produce a rate")
+}
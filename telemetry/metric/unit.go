@@ -0,0 +1,98 @@
+package metric
+
+import (
+	"math"
+	"strconv"
+)
+
+const (
+	//UnitUnknown is used when the unit of a metric couldn't be determined
+	UnitUnknown Unit = ""
+	//UnitCount is a static def for dimensionless counts
+	UnitCount Unit = "1"
+	//UnitBytes is a static def for values measured in bytes
+	UnitBytes Unit = "By"
+	//UnitRecords is a static def for values measured in records
+	UnitRecords Unit = "{record}"
+	//UnitRequests is a static def for values measured in requests
+	UnitRequests Unit = "{request}"
+	//UnitConnections is a static def for values measured in connections
+	UnitConnections Unit = "{connection}"
+	//UnitOffsets is a static def for values measured in partition offsets
+	UnitOffsets Unit = "{offset}"
+	//UnitPercent is a static def for values measured as a percentage
+	UnitPercent Unit = "%"
+)
+
+var (
+	binaryPrefixes = []string{"", "Ki", "Mi", "Gi", "Ti", "Pi", "Ei"}
+	siPrefixes     = []string{"", "k", "M", "G", "T", "P", "E"}
+)
+
+//Unit represents the unit of measure, as UCUM codes, of a metric's values
+type Unit string
+
+func (u Unit) String() string {
+	return string(u)
+}
+
+//Symbol returns the short human readable symbol of the unit
+func (u Unit) Symbol() string {
+	switch u {
+	case UnitBytes:
+		return "B"
+	case UnitRecords:
+		return "rec"
+	case UnitRequests:
+		return "req"
+	case UnitConnections:
+		return "conn"
+	case UnitOffsets:
+		return "off"
+	case UnitPercent:
+		return "%"
+	default:
+		return ""
+	}
+}
+
+//Format returns a human readable representation of the given value, such as `1.5 MiB` or `12.3k rec`
+func (u Unit) Format(value float64) string {
+	return u.format(value, "")
+}
+
+//FormatRate returns a human readable representation of the given per second value, such as `1.5 MiB/s` or `12.3k rec/s`
+func (u Unit) FormatRate(value float64) string {
+	return u.format(value, "/s")
+}
+
+func (u Unit) format(value float64, suffix string) string {
+	if u == UnitPercent {
+		return formatFloat(value) + "%" + suffix
+	}
+
+	//Bytes use binary prefixes on the symbol (1.5 MiB), everything else uses SI prefixes on the value (12.3k rec)
+	if u == UnitBytes {
+		value, prefix := scale(value, 1024, binaryPrefixes)
+		return formatFloat(value) + " " + prefix + u.Symbol() + suffix
+	}
+
+	value, prefix := scale(value, 1000, siPrefixes)
+	if symbol := u.Symbol(); symbol != "" {
+		return formatFloat(value) + prefix + " " + symbol + suffix
+	}
+	return formatFloat(value) + prefix + suffix
+}
+
+func scale(value float64, base float64, prefixes []string) (float64, string) {
+	i := 0
+	for math.Abs(value) >= base && i < len(prefixes)-1 {
+		value /= base
+		i++
+	}
+	return value, prefixes[i]
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
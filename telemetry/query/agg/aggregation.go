@@ -3,12 +3,17 @@ package agg
 import (
 	"errors"
 
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
 	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
 )
 
 const (
 	//AggSum is a static def for SUM Aggrigation
 	AggSum string = "SUM"
+	//AggMin is a static def for MIN Aggrigation
+	AggMin string = "MIN"
+	//AggMax is a static def for MAX Aggrigation
+	AggMax string = "MAX"
 )
 
 // Aggregation for a Confluent Cloud API metric
@@ -28,6 +33,7 @@ func (a Aggregation) Validate() error {
 	return nil
 }
 
+//SumOf creates an Aggregation that adds up the values of a metric
 func SumOf(metric metric.Metric) Aggregation {
 	return Aggregation{
 		Agg:    AggSum,
@@ -35,6 +41,53 @@ func SumOf(metric metric.Metric) Aggregation {
 	}
 }
 
+//MinOf creates an Aggregation that takes the smallest value of a metric
+func MinOf(metric metric.Metric) Aggregation {
+	return Aggregation{
+		Agg:    AggMin,
+		Metric: metric.Name,
+	}
+}
+
+//MaxOf creates an Aggregation that takes the largest value of a metric
+func MaxOf(metric metric.Metric) Aggregation {
+	return Aggregation{
+		Agg:    AggMax,
+		Metric: metric.Name,
+	}
+}
+
+//DefaultOf returns the Aggregation used to combine the series of a metric that a query's group by doesn't split out.
+//The API aggregates across series, never across the time buckets of a series, so SUM totals the series of deltas and gauges alike, such as the retained bytes of every partition of a Cluster.
+//Only when the group by covers every label of a gauge leaves a single series per group is MAX used, so that a point in time value is reported as is.
+func DefaultOf(m metric.Metric, groupBy ...labels.Label) Aggregation {
+	if m.Kind().IsGauge() && coversLabels(m, groupBy) {
+		return MaxOf(m)
+	}
+	return SumOf(m)
+}
+
+//coversLabels checks if the group by includes every label of the metric, using the labels of the known metric when none are given
+func coversLabels(m metric.Metric, groupBy []labels.Label) bool {
+	lbls := m.Labels
+	if len(lbls) <= 0 {
+		if known, ok := metric.Lookup(m.Name); ok {
+			lbls = known.Labels
+		}
+	}
+
+	grouped := make(map[string]bool, len(groupBy))
+	for _, l := range groupBy {
+		grouped[labels.Key(l)] = true
+	}
+	for _, l := range lbls {
+		if !grouped[labels.Key(l)] {
+			return false
+		}
+	}
+	return true
+}
+
 func Of(aggs ...Aggregation) []Aggregation {
 	return aggs
 }
@@ -0,0 +1,31 @@
+package agg
+
+import (
+	"testing"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultOf(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(SumOf(metric.KafkaServerRetainedBytes), DefaultOf(metric.KafkaServerRetainedBytes, labels.ResourceKafka), "A gauge should be totalled across the series left out of the group by")
+	assert.Equal(SumOf(metric.KafkaServerRetainedBytes), DefaultOf(metric.KafkaServerRetainedBytes, labels.ResourceKafka, labels.MetricTopic), "A gauge grouped by only some of its labels should be totalled")
+	assert.Equal(SumOf(metric.KafkaServerConsumerLag), DefaultOf(metric.KafkaServerConsumerLag, labels.MetricConsumerGroupID))
+
+	assert.Equal(MaxOf(metric.KafkaServerRetainedBytes), DefaultOf(metric.KafkaServerRetainedBytes, labels.ResourceKafka, labels.MetricTopic, labels.MetricPartition), "A gauge grouped by every label should use MAX")
+	assert.Equal(MaxOf(metric.KafkaServerConsumerLag), DefaultOf(metric.KafkaServerConsumerLag, labels.MetricConsumerGroupID, labels.MetricTopic, labels.MetricPartition))
+	assert.Equal(MaxOf(metric.KafkaServerPartition), DefaultOf(metric.KafkaServerPartition), "A gauge without labels has a single series")
+	assert.Equal(MaxOf(metric.New(metric.KafkaServerRetainedBytes.Name)), DefaultOf(metric.New(metric.KafkaServerRetainedBytes.Name), labels.MetricTopic, labels.MetricPartition), "Untyped known metrics should use their known Kind and labels")
+
+	for _, m := range []metric.Metric{
+		metric.KafkaServerReceivedBytes,
+		metric.KafkaServerRequests,
+		metric.ConnectorSentRecords,
+	} {
+		assert.Equal(SumOf(m), DefaultOf(m, labels.MetricTopic, labels.MetricPartition, labels.MetricType, labels.MetricPrincipalID), "Delta %s should default to SUM", m.Name)
+	}
+	assert.Equal(AggSum, DefaultOf(metric.New("io.confluent.kafka.server/unknown")).Agg, "Unknown metrics should default to SUM")
+}
@@ -48,6 +48,24 @@ func (fil CompoundFilter) AndGreaterThanOrEqualTo(field labels.Label, value stri
 func (fil CompoundFilter) AndNotGreaterThanOrEqualTo(field labels.Label, value string) CompoundFilter {
 	return fil.And(NotGreaterThanOrEqualTo(field, value))
 }
+func (fil CompoundFilter) AndLessThan(field labels.Label, value string) CompoundFilter {
+	return fil.And(LessThan(field, value))
+}
+func (fil CompoundFilter) AndLessThanOrEqualTo(field labels.Label, value string) CompoundFilter {
+	return fil.And(LessThanOrEqualTo(field, value))
+}
+func (fil CompoundFilter) AndBetween(field labels.Label, low string, high string) CompoundFilter {
+	return fil.And(Between(field, low, high))
+}
+func (fil CompoundFilter) AndIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.And(In(field, values...))
+}
+func (fil CompoundFilter) AndNotIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.And(NotIn(field, values...))
+}
+func (fil CompoundFilter) AndHasPrefix(field labels.Label, prefix string) CompoundFilter {
+	return fil.And(HasPrefix(field, prefix))
+}
 
 func (fil CompoundFilter) Or(filters ...Filter) CompoundFilter {
 	if fil.Op == OpOr {
@@ -74,3 +92,21 @@ func (fil CompoundFilter) OrGreaterThanOrEqualTo(field labels.Label, value strin
 func (fil CompoundFilter) OrNotGreaterThanOrEqualTo(field labels.Label, value string) CompoundFilter {
 	return fil.Or(NotGreaterThanOrEqualTo(field, value))
 }
+func (fil CompoundFilter) OrLessThan(field labels.Label, value string) CompoundFilter {
+	return fil.Or(LessThan(field, value))
+}
+func (fil CompoundFilter) OrLessThanOrEqualTo(field labels.Label, value string) CompoundFilter {
+	return fil.Or(LessThanOrEqualTo(field, value))
+}
+func (fil CompoundFilter) OrBetween(field labels.Label, low string, high string) CompoundFilter {
+	return fil.Or(Between(field, low, high))
+}
+func (fil CompoundFilter) OrIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.Or(In(field, values...))
+}
+func (fil CompoundFilter) OrNotIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.Or(NotIn(field, values...))
+}
+func (fil CompoundFilter) OrHasPrefix(field labels.Label, prefix string) CompoundFilter {
+	return fil.Or(HasPrefix(field, prefix))
+}
@@ -0,0 +1,96 @@
+package filter
+
+import "github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+
+//Values is a set of label values, keyed by their full field key such as `resource.kafka.id` or `metric.topic`, that a Filter can be evaluated against
+type Values map[string]interface{}
+
+//ValuesOf creates Values from a map of string label values
+func ValuesOf(values map[string]string) Values {
+	v := make(Values, len(values))
+	for key, val := range values {
+		v[key] = val
+	}
+	return v
+}
+
+//Get returns the raw value of a given label
+func (v Values) Get(l labels.Label) (interface{}, bool) {
+	val, ok := v[labels.Key(l)]
+	if !ok || val == nil {
+		return nil, false
+	}
+	return val, true
+}
+
+//Matcher is implemented by the Filters that can be evaluated locally, which all the Filters of this package are
+type Matcher interface {
+	Matches(values Values) bool
+}
+
+//Matches evaluates a Filter against a set of label values. A nil Filter matches everything, where as a Filter that isn't a Matcher matches nothing
+func Matches(f Filter, values Values) bool {
+	if f == nil {
+		return true
+	}
+	m, ok := f.(Matcher)
+	if !ok {
+		return false
+	}
+	return m.Matches(values)
+}
+
+//Matches evaluates the filter with the same semantics as the API.
+//EQ compares values as strings, where as GT and GTE compare numerically when both sides are numbers, otherwise as strings.
+//Data points without the field never match.
+func (fil FieldFilter) Matches(values Values) bool {
+	if fil.Field == nil {
+		return false
+	}
+
+	value, ok := values.Get(fil.Field)
+	if !ok {
+		return false
+	}
+
+	switch fil.Op {
+	case OpEq:
+		return FormatValue(value) == fil.Value
+	case OpGt:
+		return CompareValues(value, fil.Value) > 0
+	case OpGte:
+		return CompareValues(value, fil.Value) >= 0
+	default:
+		return false
+	}
+}
+
+//Matches evaluates all the sub filters. An empty AND matches everything, where as an empty OR matches nothing
+func (fil CompoundFilter) Matches(values Values) bool {
+	switch fil.Op {
+	case OpAnd:
+		for _, f := range fil.Filters {
+			if !Matches(f, values) {
+				return false
+			}
+		}
+		return true
+	case OpOr:
+		for _, f := range fil.Filters {
+			if Matches(f, values) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+//Matches evaluates the negation of the sub filter
+func (fil UnaryFilter) Matches(values Values) bool {
+	if fil.Op != OpNot {
+		return false
+	}
+	return !Matches(fil.SubFilter, values)
+}
@@ -0,0 +1,79 @@
+package filter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldFilterMatches(t *testing.T) {
+	assert := assert.New(t)
+	values := Values{
+		"resource.kafka.id": "lkc-1",
+		"metric.topic":      "orders",
+		"metric.partition":  float64(10),
+	}
+
+	assert.True(EqualTo(labels.ResourceKafka, "lkc-1").Matches(values))
+	assert.False(EqualTo(labels.ResourceKafka, "lkc-2").Matches(values))
+	assert.True(EqualTo(labels.MetricPartition, "10").Matches(values), "Numbers should equal their string form")
+	assert.False(EqualTo(labels.MetricType, "Produce").Matches(values), "Missing fields should never match")
+
+	assert.True(GreaterThan(labels.MetricPartition, "9").Matches(values), "Numbers should be compared numerically")
+	assert.False(GreaterThan(labels.MetricPartition, "10").Matches(values))
+	assert.True(GreaterThanOrEqualTo(labels.MetricPartition, "10").Matches(values))
+	assert.True(GreaterThan(labels.MetricTopic, "metrics").Matches(values), "Strings should be compared lexically")
+	assert.False(GreaterThanOrEqualTo(labels.MetricTopic, "payments").Matches(values))
+
+	assert.False(FieldFilter{Op: "LIKE", Field: labels.MetricTopic, Value: "orders"}.Matches(values), "Unknown ops should never match")
+}
+
+func TestCompoundAndUnaryFilterMatches(t *testing.T) {
+	assert := assert.New(t)
+	values := ValuesOf(map[string]string{
+		"resource.kafka.id": "lkc-1",
+		"metric.topic":      "orders",
+	})
+
+	assert.True(EqualTo(labels.ResourceKafka, "lkc-1").AndEqualTo(labels.MetricTopic, "orders").Matches(values))
+	assert.False(EqualTo(labels.ResourceKafka, "lkc-1").AndNotEqualTo(labels.MetricTopic, "orders").Matches(values))
+	assert.True(EqualTo(labels.ResourceKafka, "lkc-2").OrEqualTo(labels.MetricTopic, "orders").Matches(values))
+	assert.False(NotAnyOf(EqualTo(labels.ResourceKafka, "lkc-2"), EqualTo(labels.MetricTopic, "orders")).Matches(values))
+	assert.True(And().Matches(values), "An empty AND should match everything")
+	assert.False(Or().Matches(values), "An empty OR should match nothing")
+	assert.True(Matches(nil, values), "A nil filter should match everything")
+	assert.True(Matches(EqualTo(labels.MetricTopic, "orders"), values))
+	assert.False(Matches(struct{ Filter }{EqualTo(labels.MetricTopic, "orders")}, values), "A filter that isn't a Matcher should match nothing")
+	assert.False(Or(struct{ Filter }{EqualTo(labels.MetricTopic, "orders")}).Matches(values))
+}
+
+func TestUnmarshal(t *testing.T) {
+	assert := assert.New(t)
+
+	fil := EqualTo(labels.ResourceKafka, "lkc-1").
+		AndGreaterThanOrEqualTo(labels.MetricPartition, "2").
+		And(NotAnyOf(EqualTo(labels.MetricTopic, "a"), EqualTo(labels.MetricTopic, "b")))
+	js, err := json.Marshal(fil)
+	assert.NoError(err)
+
+	decoded, err := Unmarshal(js)
+	assert.NoError(err)
+	assert.Equal(fil, decoded, "Filters should round trip through JSON")
+
+	decoded, err = Unmarshal([]byte(`{"op":"EQ","field":"metric.partition","value":1}`))
+	assert.NoError(err)
+	assert.Equal(EqualTo(labels.MetricPartition, "1"), decoded, "Numeric values should be decoded as strings")
+
+	decoded, err = Unmarshal([]byte(`null`))
+	assert.NoError(err)
+	assert.Nil(decoded)
+
+	_, err = Unmarshal([]byte(`{"op":"LIKE","field":"metric.topic","value":"a"}`))
+	assert.Error(err, "Unknown ops should error")
+	_, err = Unmarshal([]byte(`{"op":"NOT"}`))
+	assert.Error(err, "NOT requires a sub filter")
+	_, err = Unmarshal([]byte(`{"op":"EQ","value":"a"}`))
+	assert.Error(err, "EQ requires a field")
+}
@@ -41,6 +41,24 @@ func (fil FieldFilter) AndGreaterThanOrEqualTo(field labels.Label, value string)
 func (fil FieldFilter) AndNotGreaterThanOrEqualTo(field labels.Label, value string) CompoundFilter {
 	return fil.And(NotGreaterThanOrEqualTo(field, value))
 }
+func (fil FieldFilter) AndLessThan(field labels.Label, value string) CompoundFilter {
+	return fil.And(LessThan(field, value))
+}
+func (fil FieldFilter) AndLessThanOrEqualTo(field labels.Label, value string) CompoundFilter {
+	return fil.And(LessThanOrEqualTo(field, value))
+}
+func (fil FieldFilter) AndBetween(field labels.Label, low string, high string) CompoundFilter {
+	return fil.And(Between(field, low, high))
+}
+func (fil FieldFilter) AndIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.And(In(field, values...))
+}
+func (fil FieldFilter) AndNotIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.And(NotIn(field, values...))
+}
+func (fil FieldFilter) AndHasPrefix(field labels.Label, prefix string) CompoundFilter {
+	return fil.And(HasPrefix(field, prefix))
+}
 
 func (fil FieldFilter) Or(filters ...Filter) CompoundFilter {
 	return Or(fil).Add(filters...)
@@ -63,3 +81,21 @@ func (fil FieldFilter) OrGreaterThanOrEqualTo(field labels.Label, value string)
 func (fil FieldFilter) OrNotGreaterThanOrEqualTo(field labels.Label, value string) CompoundFilter {
 	return fil.Or(NotGreaterThanOrEqualTo(field, value))
 }
+func (fil FieldFilter) OrLessThan(field labels.Label, value string) CompoundFilter {
+	return fil.Or(LessThan(field, value))
+}
+func (fil FieldFilter) OrLessThanOrEqualTo(field labels.Label, value string) CompoundFilter {
+	return fil.Or(LessThanOrEqualTo(field, value))
+}
+func (fil FieldFilter) OrBetween(field labels.Label, low string, high string) CompoundFilter {
+	return fil.Or(Between(field, low, high))
+}
+func (fil FieldFilter) OrIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.Or(In(field, values...))
+}
+func (fil FieldFilter) OrNotIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.Or(NotIn(field, values...))
+}
+func (fil FieldFilter) OrHasPrefix(field labels.Label, prefix string) CompoundFilter {
+	return fil.Or(HasPrefix(field, prefix))
+}
@@ -1,6 +1,10 @@
 package filter
 
-import "github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+import (
+	"unicode/utf8"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+)
 
 type Filter interface {
 	And(filters ...Filter) CompoundFilter
@@ -10,6 +14,12 @@ type Filter interface {
 	AndNotGreaterThan(field labels.Label, value string) CompoundFilter
 	AndGreaterThanOrEqualTo(field labels.Label, value string) CompoundFilter
 	AndNotGreaterThanOrEqualTo(field labels.Label, value string) CompoundFilter
+	AndLessThan(field labels.Label, value string) CompoundFilter
+	AndLessThanOrEqualTo(field labels.Label, value string) CompoundFilter
+	AndBetween(field labels.Label, low string, high string) CompoundFilter
+	AndIn(field labels.Label, values ...string) CompoundFilter
+	AndNotIn(field labels.Label, values ...string) CompoundFilter
+	AndHasPrefix(field labels.Label, prefix string) CompoundFilter
 	Or(filters ...Filter) CompoundFilter
 	OrEqualTo(field labels.Label, value string) CompoundFilter
 	OrNotEqualTo(field labels.Label, value string) CompoundFilter
@@ -17,6 +27,12 @@ type Filter interface {
 	OrNotGreaterThan(field labels.Label, value string) CompoundFilter
 	OrGreaterThanOrEqualTo(field labels.Label, value string) CompoundFilter
 	OrNotGreaterThanOrEqualTo(field labels.Label, value string) CompoundFilter
+	OrLessThan(field labels.Label, value string) CompoundFilter
+	OrLessThanOrEqualTo(field labels.Label, value string) CompoundFilter
+	OrBetween(field labels.Label, low string, high string) CompoundFilter
+	OrIn(field labels.Label, values ...string) CompoundFilter
+	OrNotIn(field labels.Label, values ...string) CompoundFilter
+	OrHasPrefix(field labels.Label, prefix string) CompoundFilter
 }
 
 func NotAnyOf(filters ...Filter) UnaryFilter {
@@ -83,3 +99,118 @@ func GreaterThanOrEqualTo(field labels.Label, value string) FieldFilter {
 		Value: value,
 	}
 }
+
+//DefaultMaxInValues is the default max number of values InChunks will OR together into a single filter
+const DefaultMaxInValues int = 25
+
+//LessThan compiles to NOT GTE, as the API has no LT op.
+//As such, data points without the label also match, since they're never GTE the value.
+func LessThan(field labels.Label, value string) UnaryFilter {
+	return NotGreaterThanOrEqualTo(field, value)
+}
+
+//LessThanOrEqualTo compiles to NOT GT, as the API has no LTE op.
+//As such, data points without the label also match, since they're never GT the value.
+func LessThanOrEqualTo(field labels.Label, value string) UnaryFilter {
+	return NotGreaterThan(field, value)
+}
+
+//Between matches values within the inclusive range of low to high. Compiles to GTE low AND NOT GT high
+func Between(field labels.Label, low string, high string) CompoundFilter {
+	return And(GreaterThanOrEqualTo(field, low), NotGreaterThan(field, high))
+}
+
+//In matches any of the given values. Compiles to an OR of EQs, with duplicate values removed.
+//Without any values, the empty OR matches nothing, and PostQuery returns empty results rather than sending it.
+//Use InChunks to split long lists of values across many filters.
+func In(field labels.Label, values ...string) CompoundFilter {
+	unique := uniqueValues(values)
+	filters := make([]Filter, len(unique))
+	for i, v := range unique {
+		filters[i] = EqualTo(field, v)
+	}
+	return Or(filters...)
+}
+
+//NotIn matches none of the given values. Compiles to NOT of an OR of EQs.
+//As such, data points without the label also match, as do all data points when there are no values.
+func NotIn(field labels.Label, values ...string) UnaryFilter {
+	return Not(In(field, values...))
+}
+
+//InChunks splits the de-duplicated values into many In filters of at most size values each, so that long lists can be sent as many smaller queries.
+//A size of 0 or less uses DefaultMaxInValues.
+func InChunks(field labels.Label, size int, values ...string) []CompoundFilter {
+	if size <= 0 {
+		size = DefaultMaxInValues
+	}
+
+	unique := uniqueValues(values)
+	chunks := []CompoundFilter{}
+	for start := 0; start < len(unique); start += size {
+		end := start + size
+		if end > len(unique) {
+			end = len(unique)
+		}
+		chunks = append(chunks, In(field, unique[start:end]...))
+	}
+	return chunks
+}
+
+//HasPrefix matches string values starting with the given prefix. Compiles to GTE prefix AND NOT GTE the next prefix, as the API has no prefix op.
+//As the API compares numbers numerically, this should only be used with labels holding strings.
+func HasPrefix(field labels.Label, prefix string) CompoundFilter {
+	fil := And(GreaterThanOrEqualTo(field, prefix))
+	if upper, ok := prefixUpperBound(prefix); ok {
+		fil = fil.Add(NotGreaterThanOrEqualTo(field, upper))
+	}
+	return fil
+}
+
+//prefixUpperBound returns the smallest string greater than all strings with the given prefix.
+//UTF-8 prefixes have their last rune incremented, so that the bound is valid UTF-8 as well. Any other prefix has its last byte incremented.
+func prefixUpperBound(prefix string) (string, bool) {
+	if !utf8.ValidString(prefix) {
+		b := []byte(prefix)
+		for i := len(b) - 1; i >= 0; i-- {
+			if b[i] < 0xff {
+				b[i]++
+				return string(b[:i+1]), true
+			}
+		}
+		return "", false
+	}
+
+	r := []rune(prefix)
+	for i := len(r) - 1; i >= 0; i-- {
+		if next, ok := nextRune(r[i]); ok {
+			r[i] = next
+			return string(r[:i+1]), true
+		}
+	}
+	return "", false
+}
+
+//nextRune returns the rune after r, skipping the surrogate halves that can't be encoded as UTF-8
+func nextRune(r rune) (rune, bool) {
+	switch {
+	case r >= utf8.MaxRune:
+		return 0, false
+	case r+1 >= 0xD800 && r+1 <= 0xDFFF:
+		return 0xE000, true
+	default:
+		return r + 1, true
+	}
+}
+
+func uniqueValues(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			unique = append(unique, v)
+		}
+	}
+	return unique
+}
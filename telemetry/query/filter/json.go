@@ -0,0 +1,71 @@
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+)
+
+//rawFilter is the JSON form shared by all the Filter types
+type rawFilter struct {
+	Op      string            `json:"op"`
+	Field   string            `json:"field"`
+	Value   interface{}       `json:"value"`
+	Filters []json.RawMessage `json:"filters"`
+	Filter  json.RawMessage   `json:"filter"`
+}
+
+//Unmarshal decodes a Filter from its JSON form, as sent to the API. A JSON null decodes to a nil Filter
+func Unmarshal(js []byte) (Filter, error) {
+	raw := rawFilter{}
+	if err := json.Unmarshal(js, &raw); err != nil {
+		return nil, err
+	}
+	if raw.Op == "" && raw.Field == "" && raw.Filters == nil && raw.Filter == nil {
+		return nil, nil
+	}
+
+	switch raw.Op {
+	case OpEq, OpGt, OpGte:
+		if raw.Field == "" {
+			return nil, fmt.Errorf("%s filters require a field", raw.Op)
+		}
+		fil := FieldFilter{
+			Op:    raw.Op,
+			Field: labels.FromKey(raw.Field),
+		}
+		switch v := raw.Value.(type) {
+		case string:
+			fil.Value = v
+		case nil:
+			return nil, fmt.Errorf("%s filters require a value", raw.Op)
+		default:
+			fil.Value = fmt.Sprint(v)
+		}
+		return fil, nil
+	case OpAnd, OpOr:
+		fil := CompoundFilter{Op: raw.Op}
+		for _, sub := range raw.Filters {
+			f, err := Unmarshal(sub)
+			if err != nil {
+				return nil, err
+			}
+			if f != nil {
+				fil.Filters = append(fil.Filters, f)
+			}
+		}
+		return fil, nil
+	case OpNot:
+		if raw.Filter == nil {
+			return nil, fmt.Errorf("%s filters require a sub filter", raw.Op)
+		}
+		sub, err := Unmarshal(raw.Filter)
+		if err != nil {
+			return nil, err
+		}
+		return Not(sub), nil
+	default:
+		return nil, fmt.Errorf("Unsupported filter op `%s`", raw.Op)
+	}
+}
@@ -0,0 +1,202 @@
+package filter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+)
+
+//ErrContradiction is returned when normalizing a Filter that can never match, such as `a = x AND a = y`
+var ErrContradiction = errors.New("Filter is a contradiction and can never match any data points")
+
+//truth is the known outcome of a normalized filter, regardless of the data points it's evaluated against
+type truth int
+
+const (
+	truthUnknown truth = iota
+	truthAlways
+	truthNever
+)
+
+//Normalize simplifies a Filter tree, returning an equivalent but flatter filter. It:
+//
+//	flattens nested ANDs and ORs, such as And(And(a), b) into And(a, b)
+//	unwraps single child ANDs and ORs
+//	removes double negation, such as Not(Not(a)) into a
+//	applies De Morgan's laws when most children are negated, such as Not(And(Not(a), Not(b))) into Or(a, b)
+//	drops duplicate children
+//	detects contradictions, such as `a = x AND a = y`, and tautologies, such as `a = x OR a != x`
+//
+//A nil Filter is returned when the filter matches everything, and ErrContradiction when it can never match.
+func Normalize(f Filter) (Filter, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	n, t := normalize(f)
+	switch t {
+	case truthAlways:
+		return nil, nil
+	case truthNever:
+		return nil, ErrContradiction
+	}
+	return n, nil
+}
+
+func normalize(f Filter) (Filter, truth) {
+	switch fil := f.(type) {
+	case UnaryFilter:
+		if fil.Op != OpNot {
+			return fil, truthUnknown
+		}
+		if fil.SubFilter == nil {
+			return nil, truthNever
+		}
+
+		sub, t := normalize(fil.SubFilter)
+		switch t {
+		case truthAlways:
+			return nil, truthNever
+		case truthNever:
+			return nil, truthAlways
+		}
+
+		negated, deMorgan := negate(sub)
+		if deMorgan {
+			return normalize(negated)
+		}
+		return negated, truthUnknown
+	case CompoundFilter:
+		if fil.Op != OpAnd && fil.Op != OpOr {
+			return fil, truthUnknown
+		}
+		return normalizeCompound(fil)
+	default:
+		return f, truthUnknown
+	}
+}
+
+func normalizeCompound(fil CompoundFilter) (Filter, truth) {
+	//Within an AND, a child that always matches is redundant and one that never matches makes the whole AND never match. The reverse for OR.
+	redundant, absorbing := truthAlways, truthNever
+	if fil.Op == OpOr {
+		redundant, absorbing = truthNever, truthAlways
+	}
+
+	children := []Filter{}
+	seen := map[string]bool{}
+	add := func(f Filter) {
+		key := filterKey(f)
+		if !seen[key] {
+			seen[key] = true
+			children = append(children, f)
+		}
+	}
+
+	for _, c := range fil.Filters {
+		//A nil child matches everything
+		t := truthAlways
+		if c != nil {
+			var f Filter
+			f, t = normalize(c)
+			if t == truthUnknown {
+				if sub, ok := f.(CompoundFilter); ok && sub.Op == fil.Op {
+					for _, s := range sub.Filters {
+						add(s)
+					}
+				} else {
+					add(f)
+				}
+			}
+		}
+
+		switch t {
+		case redundant:
+			continue
+		case absorbing:
+			return nil, absorbing
+		}
+	}
+
+	if conflicting(fil.Op, children) {
+		return nil, absorbing
+	}
+
+	switch len(children) {
+	case 0:
+		return nil, redundant
+	case 1:
+		return children[0], truthUnknown
+	}
+	return CompoundFilter{Op: fil.Op, Filters: children}, truthUnknown
+}
+
+//negate returns the negation of a normalized filter, and if De Morgan's laws were applied in doing so
+func negate(f Filter) (Filter, bool) {
+	switch fil := f.(type) {
+	case UnaryFilter:
+		if fil.Op == OpNot {
+			return fil.SubFilter, false
+		}
+	case CompoundFilter:
+		negated := 0
+		for _, c := range fil.Filters {
+			if u, ok := c.(UnaryFilter); ok && u.Op == OpNot {
+				negated++
+			}
+		}
+		if negated*2 > len(fil.Filters) {
+			op := OpAnd
+			if fil.Op == OpAnd {
+				op = OpOr
+			}
+			children := make([]Filter, len(fil.Filters))
+			for i, c := range fil.Filters {
+				children[i], _ = negate(c)
+			}
+			return CompoundFilter{Op: op, Filters: children}, true
+		}
+	}
+	return Not(f), false
+}
+
+//conflicting checks if the children of an AND contain both `a = x` and `a = y`, or `a = x` and `NOT a = x`, making it a contradiction.
+//For the children of an OR only the latter is checked, making it a tautology.
+func conflicting(op string, children []Filter) bool {
+	equal := map[string][]string{}
+	notEqual := map[string]bool{}
+	for _, c := range children {
+		switch fil := c.(type) {
+		case FieldFilter:
+			if fil.Op == OpEq && fil.Field != nil {
+				key := labels.Key(fil.Field)
+				equal[key] = append(equal[key], fil.Value)
+			}
+		case UnaryFilter:
+			if sub, ok := fil.SubFilter.(FieldFilter); ok && fil.Op == OpNot && sub.Op == OpEq && sub.Field != nil {
+				notEqual[labels.Key(sub.Field)+"\x00"+sub.Value] = true
+			}
+		}
+	}
+
+	for key, values := range equal {
+		if op == OpAnd && len(values) > 1 {
+			return true
+		}
+		for _, value := range values {
+			if notEqual[key+"\x00"+value] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func filterKey(f Filter) string {
+	js, err := json.Marshal(f)
+	if err != nil {
+		return ""
+	}
+	return string(js)
+}
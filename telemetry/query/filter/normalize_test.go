@@ -0,0 +1,77 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	topicA = EqualTo(labels.MetricTopic, "a")
+	topicB = EqualTo(labels.MetricTopic, "b")
+	kafka1 = EqualTo(labels.ResourceKafka, "lkc-1")
+	kafka2 = EqualTo(labels.ResourceKafka, "lkc-2")
+	part1  = GreaterThan(labels.MetricPartition, "1")
+)
+
+func normalized(t *testing.T, f Filter) Filter {
+	n, err := Normalize(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestNormalizeFlattens(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(And(kafka1, topicA, part1), normalized(t, And(And(kafka1), And(topicA, And(part1)))), "Nested ANDs should be flattened")
+	assert.Equal(Or(kafka1, kafka2, topicA), normalized(t, kafka1.Or(kafka2).Or(Or(topicA))), "Nested ORs should be flattened")
+	assert.Equal(And(kafka1, Or(topicA, topicB)), normalized(t, And(kafka1, Or(topicA, topicB))), "Mixed ops should not be flattened")
+	assert.Equal(kafka1, normalized(t, And(Or(kafka1))), "Single child compounds should be unwrapped")
+	assert.Equal(And(kafka1, topicA), normalized(t, And(kafka1, topicA, kafka1, And(topicA))), "Duplicates should be dropped")
+}
+
+func TestNormalizeNegation(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(topicA, normalized(t, Not(Not(topicA))), "Double negation should be removed")
+	assert.Equal(Not(topicA), normalized(t, Not(Not(Not(topicA)))))
+	assert.Equal(Or(kafka1, topicA), normalized(t, Not(And(Not(kafka1), Not(topicA)))), "De Morgan's laws should remove the negations")
+	assert.Equal(And(kafka1, topicA, Not(topicB)), normalized(t, NotAnyOf(Not(kafka1), Not(topicA), topicB)))
+	assert.Equal(Not(And(kafka1, topicA)), normalized(t, Not(And(kafka1, topicA))), "De Morgan's laws should only be applied when they help")
+}
+
+func TestNormalizeContradictions(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := Normalize(And(topicA, kafka1, topicB))
+	assert.Equal(ErrContradiction, err, "A field can't equal 2 values")
+	_, err = Normalize(topicA.AndNotEqualTo(labels.MetricTopic, "a"))
+	assert.Equal(ErrContradiction, err, "A field can't equal and not equal the same value")
+	_, err = Normalize(Not(Or(kafka1, NotEqualTo(labels.ResourceKafka, "lkc-1"))))
+	assert.Equal(ErrContradiction, err, "The negation of a tautology is a contradiction")
+
+	assert.Equal(kafka1, normalized(t, Or(And(topicA, topicB), kafka1)), "Contradicting branches of an OR should be dropped")
+	assert.Equal(Or(topicA, topicB), normalized(t, Or(topicA, topicB)), "Different values within an OR are not a contradiction")
+	assert.Nil(normalized(t, Or(topicA, Not(topicA))), "A tautology should match everything")
+	assert.Equal(kafka1, normalized(t, And(kafka1, Or(topicA, Not(topicA)))), "Tautologies within an AND should be dropped")
+	assert.Nil(normalized(t, nil))
+}
+
+func TestNormalizeIsEquivalent(t *testing.T) {
+	assert := assert.New(t)
+
+	fil := Not(And(Not(kafka1), Not(Or(topicA, And(topicB, topicB))), kafka2))
+	n := normalized(t, fil)
+	for _, values := range []Values{
+		{"resource.kafka.id": "lkc-1", "metric.topic": "a"},
+		{"resource.kafka.id": "lkc-2", "metric.topic": "a"},
+		{"resource.kafka.id": "lkc-2", "metric.topic": "c"},
+		{"resource.kafka.id": "lkc-3", "metric.topic": "b"},
+		{},
+	} {
+		assert.Equal(Matches(fil, values), Matches(n, values), "Normalized filter should match the same values as %v", values)
+	}
+}
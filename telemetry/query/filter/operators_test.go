@@ -0,0 +1,99 @@
+package filter
+
+import (
+	"testing"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLessThan(t *testing.T) {
+	assert := assert.New(t)
+
+	fil := LessThan(labels.MetricPartition, "5")
+	assert.Equal(NotGreaterThanOrEqualTo(labels.MetricPartition, "5"), fil, "LessThan should compile to NOT GTE")
+	assert.True(fil.Matches(Values{"metric.partition": float64(4)}))
+	assert.False(fil.Matches(Values{"metric.partition": float64(5)}))
+	assert.True(fil.Matches(Values{"metric.topic": "orders"}), "Data points without the label should match")
+
+	fil = LessThanOrEqualTo(labels.MetricPartition, "5")
+	assert.Equal(NotGreaterThan(labels.MetricPartition, "5"), fil, "LessThanOrEqualTo should compile to NOT GT")
+	assert.True(fil.Matches(Values{"metric.partition": float64(5)}))
+	assert.False(fil.Matches(Values{"metric.partition": float64(6)}))
+}
+
+func TestBetween(t *testing.T) {
+	assert := assert.New(t)
+
+	fil := Between(labels.MetricPartition, "2", "10")
+	assert.Equal(OpAnd, fil.Op)
+	for partition, expected := range map[float64]bool{1: false, 2: true, 9: true, 10: true, 11: false} {
+		assert.Equal(expected, fil.Matches(Values{"metric.partition": partition}), "Partition %v should be within the inclusive range", partition)
+	}
+}
+
+func TestIn(t *testing.T) {
+	assert := assert.New(t)
+
+	fil := In(labels.MetricTopic, "a", "b", "a")
+	assert.Equal(Or(EqualTo(labels.MetricTopic, "a"), EqualTo(labels.MetricTopic, "b")), fil, "Duplicate values should be removed")
+	assert.True(fil.Matches(Values{"metric.topic": "b"}))
+	assert.False(fil.Matches(Values{"metric.topic": "c"}))
+
+	not := NotIn(labels.MetricTopic, "a", "b")
+	assert.Equal(Not(fil), not)
+	assert.True(not.Matches(Values{"metric.topic": "c"}))
+	assert.False(not.Matches(Values{"metric.topic": "a"}))
+	assert.True(not.Matches(Values{"metric.partition": "1"}), "Data points without the label should match")
+
+	empty := In(labels.MetricTopic)
+	assert.False(empty.Matches(Values{"metric.topic": "a"}), "In without values should match nothing")
+	_, err := Normalize(empty)
+	assert.Equal(ErrContradiction, err, "In without values should never be sent")
+	assert.True(NotIn(labels.MetricTopic).Matches(Values{"metric.topic": "a"}))
+
+	chunks := InChunks(labels.MetricTopic, 2, "a", "b", "c", "a", "d", "e")
+	assert.Len(chunks, 3)
+	assert.Equal(In(labels.MetricTopic, "a", "b"), chunks[0])
+	assert.Equal(In(labels.MetricTopic, "e"), chunks[2])
+	assert.Len(InChunks(labels.MetricTopic, 0, make([]string, DefaultMaxInValues)...), 1, "Duplicates should be removed before chunking")
+}
+
+func TestHasPrefix(t *testing.T) {
+	assert := assert.New(t)
+
+	fil := HasPrefix(labels.MetricTopic, "orders-")
+	assert.Equal(And(GreaterThanOrEqualTo(labels.MetricTopic, "orders-"), NotGreaterThanOrEqualTo(labels.MetricTopic, "orders.")), fil)
+	assert.True(fil.Matches(Values{"metric.topic": "orders-"}))
+	assert.True(fil.Matches(Values{"metric.topic": "orders-eu"}))
+	assert.False(fil.Matches(Values{"metric.topic": "orders"}))
+	assert.False(fil.Matches(Values{"metric.topic": "ordersx"}))
+
+	fil = HasPrefix(labels.MetricTopic, "a\xff")
+	assert.Equal(And(GreaterThanOrEqualTo(labels.MetricTopic, "a\xff"), NotGreaterThanOrEqualTo(labels.MetricTopic, "b")), fil, "Trailing max bytes should be dropped from the upper bound")
+	assert.Equal(And(GreaterThanOrEqualTo(labels.MetricTopic, "\xff")), HasPrefix(labels.MetricTopic, "\xff"), "A prefix without an upper bound should only have a lower bound")
+
+	fil = HasPrefix(labels.MetricTopic, "caf\u00ff")
+	assert.Equal(And(GreaterThanOrEqualTo(labels.MetricTopic, "caf\u00ff"), NotGreaterThanOrEqualTo(labels.MetricTopic, "caf\u0100")), fil, "Non-ASCII prefixes should be incremented by rune")
+	assert.True(fil.Matches(Values{"metric.topic": "caf\u00ff-eu"}))
+	assert.False(fil.Matches(Values{"metric.topic": "caf\u0100"}))
+	assert.Equal(And(GreaterThanOrEqualTo(labels.MetricTopic, "a\U0010FFFF"), NotGreaterThanOrEqualTo(labels.MetricTopic, "b")), HasPrefix(labels.MetricTopic, "a\U0010FFFF"), "Trailing max runes should be dropped from the upper bound")
+	assert.Equal(And(GreaterThanOrEqualTo(labels.MetricTopic, "\uD7FF"), NotGreaterThanOrEqualTo(labels.MetricTopic, "\uE000")), HasPrefix(labels.MetricTopic, "\uD7FF"), "Surrogates should be skipped")
+}
+
+func TestChainedOperators(t *testing.T) {
+	assert := assert.New(t)
+	kafka := EqualTo(labels.ResourceKafka, "lkc-1")
+
+	for _, fil := range []Filter{kafka, And(kafka), Not(Not(kafka))} {
+		chained := fil.AndIn(labels.MetricTopic, "a", "b").AndBetween(labels.MetricPartition, "0", "3").AndHasPrefix(labels.MetricType, "Fetch")
+		assert.Equal(OpAnd, chained.Op)
+		assert.True(chained.Matches(Values{"resource.kafka.id": "lkc-1", "metric.topic": "b", "metric.partition": "3", "metric.type": "FetchConsumer"}))
+		assert.False(chained.Matches(Values{"resource.kafka.id": "lkc-1", "metric.topic": "b", "metric.partition": "4", "metric.type": "FetchConsumer"}))
+
+		chained = fil.OrNotIn(labels.MetricTopic, "a").OrLessThan(labels.MetricPartition, "1").OrLessThanOrEqualTo(labels.MetricPartition, "1")
+		assert.Equal(OpOr, chained.Op)
+		assert.True(chained.Matches(Values{"resource.kafka.id": "lkc-2", "metric.topic": "b"}))
+		assert.False(chained.Matches(Values{"resource.kafka.id": "lkc-2", "metric.topic": "a", "metric.partition": "2"}))
+	}
+}
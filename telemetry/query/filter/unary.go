@@ -33,6 +33,24 @@ func (fil UnaryFilter) AndGreaterThanOrEqualTo(field labels.Label, value string)
 func (fil UnaryFilter) AndNotGreaterThanOrEqualTo(field labels.Label, value string) CompoundFilter {
 	return fil.And(NotGreaterThanOrEqualTo(field, value))
 }
+func (fil UnaryFilter) AndLessThan(field labels.Label, value string) CompoundFilter {
+	return fil.And(LessThan(field, value))
+}
+func (fil UnaryFilter) AndLessThanOrEqualTo(field labels.Label, value string) CompoundFilter {
+	return fil.And(LessThanOrEqualTo(field, value))
+}
+func (fil UnaryFilter) AndBetween(field labels.Label, low string, high string) CompoundFilter {
+	return fil.And(Between(field, low, high))
+}
+func (fil UnaryFilter) AndIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.And(In(field, values...))
+}
+func (fil UnaryFilter) AndNotIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.And(NotIn(field, values...))
+}
+func (fil UnaryFilter) AndHasPrefix(field labels.Label, prefix string) CompoundFilter {
+	return fil.And(HasPrefix(field, prefix))
+}
 
 func (fil UnaryFilter) Or(filters ...Filter) CompoundFilter {
 	return Or(fil).Add(filters...)
@@ -55,3 +73,21 @@ func (fil UnaryFilter) OrGreaterThanOrEqualTo(field labels.Label, value string)
 func (fil UnaryFilter) OrNotGreaterThanOrEqualTo(field labels.Label, value string) CompoundFilter {
 	return fil.Or(NotGreaterThanOrEqualTo(field, value))
 }
+func (fil UnaryFilter) OrLessThan(field labels.Label, value string) CompoundFilter {
+	return fil.Or(LessThan(field, value))
+}
+func (fil UnaryFilter) OrLessThanOrEqualTo(field labels.Label, value string) CompoundFilter {
+	return fil.Or(LessThanOrEqualTo(field, value))
+}
+func (fil UnaryFilter) OrBetween(field labels.Label, low string, high string) CompoundFilter {
+	return fil.Or(Between(field, low, high))
+}
+func (fil UnaryFilter) OrIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.Or(In(field, values...))
+}
+func (fil UnaryFilter) OrNotIn(field labels.Label, values ...string) CompoundFilter {
+	return fil.Or(NotIn(field, values...))
+}
+func (fil UnaryFilter) OrHasPrefix(field labels.Label, prefix string) CompoundFilter {
+	return fil.Or(HasPrefix(field, prefix))
+}
@@ -0,0 +1,47 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//FormatValue formats a raw label value, as decoded from the API, the same way the API compares it in EQ filters
+func FormatValue(raw interface{}) string {
+	switch val := raw.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+//CompareValues compares two raw label values the same way the API does in GT and GTE filters, numerically when both are numbers, otherwise as strings
+func CompareValues(a, b interface{}) int {
+	x, xErr := parseNumber(a)
+	y, yErr := parseNumber(b)
+	if xErr == nil && yErr == nil {
+		if x < y {
+			return -1
+		} else if x > y {
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(FormatValue(a), FormatValue(b))
+}
+
+func parseNumber(raw interface{}) (float64, error) {
+	switch val := raw.(type) {
+	case float64:
+		return val, nil
+	case string:
+		return strconv.ParseFloat(val, 64)
+	default:
+		return 0, fmt.Errorf("Value `%v` is not a number", raw)
+	}
+}
@@ -2,7 +2,9 @@ package granularity
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
+	"strings"
 	"time"
 
 	"github.com/rickb777/date/period"
@@ -41,6 +43,20 @@ var (
 		All,
 	}
 
+	//definedGranularities is every Granularity with a static def, including those not listed as available
+	definedGranularities []Granularity = []Granularity{
+		OneMin,
+		FiveMin,
+		FifteenMin,
+		ThirtyMin,
+		OneHour,
+		FourHours,
+		SixHours,
+		TwelveHours,
+		OneDay,
+		All,
+	}
+
 	maxDuration time.Duration = (math.MaxInt64 * time.Nanosecond)
 )
 
@@ -74,6 +90,16 @@ func (g Granularity) IsValid() bool {
 	return false
 }
 
+//Parse finds the statically defined Granularity for a given ISO-8601 duration string, such as `PT1H`, or `ALL`
+func Parse(g string) (Granularity, error) {
+	for _, gran := range definedGranularities {
+		if strings.EqualFold(gran.String(), g) {
+			return gran, nil
+		}
+	}
+	return Granularity{}, fmt.Errorf("Unknown granularity `%s`", g)
+}
+
 func newGranularity(g string) Granularity {
 	if g == "ALL" {
 		p, _ := period.NewOf(maxDuration)
@@ -35,3 +35,26 @@ func TestStaticDefs_Duration(t *testing.T) {
 	assert.Equal((12 * time.Hour), TwelveHours.Duration, "TwelveHours has wrong Duration")
 	assert.Equal((24 * time.Hour), OneDay.Duration, "OneDay has wrong Duration")
 }
+
+func TestParse(t *testing.T) {
+	assert := assert.New(t)
+
+	g, err := Parse("PT1H")
+	assert.Nil(err)
+	assert.Equal(OneHour, g)
+
+	g, err = Parse("pt4h")
+	assert.Nil(err)
+	assert.Equal(FourHours, g)
+
+	_, err = Parse("PT2H")
+	assert.NotNil(err, "Granularities without a static def should fail to parse")
+}
+
+func TestIsValid(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.True(OneHour.IsValid(), "Static defs should be valid")
+	assert.True(All.IsValid(), "ALL should be valid")
+	assert.False(Granularity{}.IsValid(), "An empty Granularity shouldn't be valid")
+}
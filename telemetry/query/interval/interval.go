@@ -33,6 +33,20 @@ func (i Interval) MinGranularity() granularity.Granularity {
 	return granularity.OneMin
 }
 
+//MaxGranularity returns the coarsest Granularity, excluding ALL, that is valid for the Interval
+func (i Interval) MaxGranularity() granularity.Granularity {
+	max := granularity.OneMin
+	for _, g := range granularity.AvailableGranularities {
+		if g.Equals(granularity.All) {
+			continue
+		}
+		if i.IsValidGranularity(g) && g.Duration > max.Duration {
+			max = g
+		}
+	}
+	return max
+}
+
 //IsValidInterval Checks if a Grandularity is valid for a given Interval of time.
 //Granularities have a max on how big of an internval they can be paired with.
 func (i Interval) IsValidGranularity(g granularity.Granularity) bool {
@@ -54,9 +68,11 @@ func StartingFrom(start time.Time, duration time.Duration) Interval {
 	}
 }
 
+//EndingAt creates an Interval of the given duration ending at the given time.
+//The end is truncated to the minute, so that the Interval never reaches into the future.
 func EndingAt(duration time.Duration, end time.Time) Interval {
 	return Interval{
-		TimeSpan:     timespan.TimeSpanOf(end.Round(time.Minute), -duration),
+		TimeSpan:     timespan.TimeSpanOf(end.Truncate(time.Minute).Add(-duration), duration),
 		withDuration: true,
 	}
 }
@@ -2,6 +2,7 @@ package interval
 
 import (
 	"testing"
+	"time"
 
 	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
 	"github.com/stretchr/testify/assert"
@@ -113,3 +114,40 @@ func TestValidGranularity(t *testing.T) {
 	assert.True(interval.IsValidGranularity(granularity.OneHour))
 
 }
+
+func TestMaxGranularity(t *testing.T) {
+	assert := assert.New(t)
+
+	interval, e := Parse("2021-04-19T15:15:00-06:00/PT1H")
+	if e != nil {
+		t.Error(e)
+	}
+	assert.Equal(granularity.OneHour, interval.MaxGranularity())
+
+	interval, e = Parse("2021-04-19T15:15:00-06:00/PT20M")
+	if e != nil {
+		t.Error(e)
+	}
+	assert.Equal(granularity.FifteenMin, interval.MaxGranularity())
+
+	interval, e = Parse("2021-04-19T15:15:00-06:00/P8DT")
+	if e != nil {
+		t.Error(e)
+	}
+	assert.Equal(granularity.OneDay, interval.MaxGranularity())
+}
+
+func TestEndingAt(t *testing.T) {
+	assert := assert.New(t)
+
+	end := time.Date(2021, 4, 20, 16, 15, 0, 0, time.UTC)
+	interval := EndingAt(time.Hour, end)
+	assert.Equal(time.Hour, interval.Duration(), "Duration should be positive")
+	assert.Equal(end, interval.End())
+	assert.Equal("2021-04-20T15:15:00Z/PT1H", interval.String())
+	assert.Equal(granularity.OneMin, interval.MinGranularity())
+
+	interval = EndingAt(time.Hour, end.Add(59*time.Second))
+	assert.Equal(end, interval.End(), "The end should be truncated, not rounded up into the future")
+	assert.Equal(time.Hour, interval.Duration())
+}
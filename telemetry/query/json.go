@@ -0,0 +1,76 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+)
+
+//rawQuery is the JSON form of a Query, as sent to the API
+type rawQuery struct {
+	Aggregations []agg.Aggregation `json:"aggregations"`
+	Filter       json.RawMessage   `json:"filter"`
+	Granularity  string            `json:"granularity"`
+	GroupBy      []string          `json:"group_by"`
+	Intervals    []string          `json:"intervals"`
+	Limit        int               `json:"limit"`
+	Metric       string            `json:"metric"`
+}
+
+//Unmarshal decodes a Query from its JSON form, as sent to the API, and validates it.
+//Known metrics are resolved to their full definitions, so that their kind and labels are available.
+func Unmarshal(js []byte) (Query, error) {
+	raw := rawQuery{}
+	if err := json.Unmarshal(js, &raw); err != nil {
+		return Query{}, err
+	}
+
+	q := Query{
+		Aggregations: raw.Aggregations,
+		Limit:        raw.Limit,
+	}
+
+	if raw.Metric != "" {
+		m, ok := metric.Lookup(raw.Metric)
+		if !ok {
+			m = metric.New(raw.Metric)
+		}
+		q.Metric = m
+	}
+
+	if raw.Filter != nil {
+		fil, err := filter.Unmarshal(raw.Filter)
+		if err != nil {
+			return Query{}, fmt.Errorf("Invalid filter: %s", err)
+		}
+		q.Filter = fil
+	}
+
+	if raw.Granularity != "" {
+		gran, err := granularity.Parse(raw.Granularity)
+		if err != nil {
+			return Query{}, err
+		}
+		q.Granularity = gran
+	}
+
+	for _, key := range raw.GroupBy {
+		q.GroupBy = q.GroupBy.And(labels.FromKey(key))
+	}
+
+	for _, i := range raw.Intervals {
+		inter, err := interval.Parse(i)
+		if err != nil {
+			return Query{}, fmt.Errorf("Invalid interval: %s", err)
+		}
+		q.Intervals = append(q.Intervals, inter)
+	}
+
+	return q, q.Validate()
+}
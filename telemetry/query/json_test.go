@@ -0,0 +1,51 @@
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/group"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshal(t *testing.T) {
+	assert := assert.New(t)
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	q := Query{
+		Aggregations: []agg.Aggregation{agg.SumOf(metric.KafkaServerReceivedBytes)},
+		Filter:       filter.EqualTo(labels.ResourceKafka, "lkc-1").AndEqualTo(labels.MetricTopic, "orders"),
+		Granularity:  granularity.OneHour,
+		GroupBy:      group.By(labels.MetricPartition),
+		Intervals:    interval.Of(interval.Between(start, start.Add(6*time.Hour))),
+		Limit:        100,
+		Metric:       metric.KafkaServerReceivedBytes,
+	}
+	js, err := q.ToJSON()
+	assert.NoError(err)
+
+	decoded, err := Unmarshal(js)
+	assert.NoError(err)
+	assert.Equal(metric.KafkaServerReceivedBytes, decoded.Metric, "Known metrics should resolve to their full definition")
+	assert.Equal(granularity.OneHour.String(), decoded.Granularity.String(), "Granularity doesn't match")
+	assert.Equal(100, decoded.Limit, "Limit doesn't match")
+
+	redone, err := decoded.ToJSON()
+	assert.NoError(err)
+	assert.JSONEq(string(js), string(redone), "Query should round trip through JSON")
+
+	_, err = Unmarshal([]byte(`{"metric":"received_bytes","granularity":"PT2H"}`))
+	assert.Error(err, "Unknown granularities should be rejected")
+
+	_, err = Unmarshal([]byte(`{"metric":"received_bytes","granularity":"PT1M","intervals":["2021-01-01T00:00:00Z/P2D"]}`))
+	assert.Error(err, "Intervals too large for the granularity should be rejected")
+
+	_, err = Unmarshal([]byte(`{"metric":"received_bytes","filter":{"op":"LT","field":"metric.topic","value":"a"}}`))
+	assert.Error(err, "Unsupported filter ops should be rejected")
+}
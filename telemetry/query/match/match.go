@@ -0,0 +1,89 @@
+package match
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+)
+
+//Matcher is used to filter label values client side, for patterns the API can't filter on itself
+type Matcher interface {
+	Matches(value string) bool
+}
+
+//MatcherFunc allows a simple func to be used as a Matcher
+type MatcherFunc func(value string) bool
+
+func (f MatcherFunc) Matches(value string) bool {
+	return f(value)
+}
+
+//LabelMatcher pairs a Matcher with the Label whose values it should be applied to
+type LabelMatcher struct {
+	Label   labels.Label
+	Matcher Matcher
+}
+
+//Label creates a new LabelMatcher for a given Label
+func Label(label labels.Label, matcher Matcher) LabelMatcher {
+	return LabelMatcher{
+		Label:   label,
+		Matcher: matcher,
+	}
+}
+
+//Any matches every value
+func Any() Matcher {
+	return MatcherFunc(func(value string) bool {
+		return true
+	})
+}
+
+//Exact matches any of the given values exactly
+func Exact(values ...string) Matcher {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return MatcherFunc(func(value string) bool {
+		return set[value]
+	})
+}
+
+//Prefix matches values starting with the given prefix
+func Prefix(prefix string) Matcher {
+	return MatcherFunc(func(value string) bool {
+		return strings.HasPrefix(value, prefix)
+	})
+}
+
+//Glob matches values against a shell style pattern, such as `orders-*`. An invalid pattern matches nothing.
+func Glob(pattern string) Matcher {
+	return MatcherFunc(func(value string) bool {
+		matched, err := path.Match(pattern, value)
+		return err == nil && matched
+	})
+}
+
+//Regex matches values against a regular expression
+func Regex(expr string) (Matcher, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return MatcherFunc(re.MatchString), nil
+}
+
+//MustRegex is like Regex but panics if the expression can not be parsed
+func MustRegex(expr string) Matcher {
+	return MatcherFunc(regexp.MustCompile(expr).MatchString)
+}
+
+//Not inverts the given Matcher
+func Not(matcher Matcher) Matcher {
+	return MatcherFunc(func(value string) bool {
+		return !matcher.Matches(value)
+	})
+}
@@ -0,0 +1,46 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefix(t *testing.T) {
+	assert := assert.New(t)
+
+	m := Prefix("orders-")
+	assert.True(m.Matches("orders-eu"))
+	assert.False(m.Matches("payments"))
+}
+
+func TestGlob(t *testing.T) {
+	assert := assert.New(t)
+
+	m := Glob("orders-*")
+	assert.True(m.Matches("orders-eu"))
+	assert.False(m.Matches("my-orders-eu"))
+	assert.False(Glob("[").Matches("["), "Invalid patterns should match nothing")
+}
+
+func TestRegex(t *testing.T) {
+	assert := assert.New(t)
+
+	m, err := Regex("^orders-(eu|us)$")
+	assert.Nil(err)
+	assert.True(m.Matches("orders-eu"))
+	assert.False(m.Matches("orders-apac"))
+
+	_, err = Regex("(")
+	assert.NotNil(err, "Invalid expressions should error")
+}
+
+func TestExactAndNot(t *testing.T) {
+	assert := assert.New(t)
+
+	m := Exact("a", "b")
+	assert.True(m.Matches("a"))
+	assert.False(m.Matches("c"))
+	assert.True(Not(m).Matches("c"))
+	assert.True(Any().Matches(""))
+}
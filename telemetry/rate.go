@@ -0,0 +1,27 @@
+package telemetry
+
+import (
+	"fmt"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+)
+
+//Rates converts the data points of a delta metric, aggregated up to the given granularity, into per second rates.
+//Gauges are point in time values that can't be turned into a rate, so an error is returned for any none delta metric.
+func Rates(m metric.Metric, gran granularity.Granularity, data []response.Telemetry) ([]response.Telemetry, error) {
+	if !m.Kind().IsDelta() {
+		return nil, fmt.Errorf("Rates can only be computed for delta metrics. %s is of kind `%s`", m.Name, m.Kind())
+	}
+	if gran.Equals(granularity.All) {
+		return nil, fmt.Errorf("Rates can not be computed for the %s granularity", gran)
+	}
+
+	rates := make([]response.Telemetry, len(data))
+	for i, d := range data {
+		d.Value, _ = m.Rate(d.Value, gran.Duration)
+		rates[i] = d
+	}
+	return rates, nil
+}
@@ -10,6 +10,14 @@ var (
 	ResourceTypeConnector      ResourceType = NewResourceType("connector", metric.KnownConnectorMetrics, labels.ResourceConnector)
 	ResourceTypeKSQL           ResourceType = NewResourceType("ksql", metric.KnownKSQLMetrics, labels.ResourceKSQL)
 	ResourceTypeSchemaRegistry ResourceType = NewResourceType("schema_registry", metric.KnownSchemaRegMetrics, labels.ResourceSchemaRegistry)
+
+	//KnownResourceTypes is a collection of all the known Resource Types at this time
+	KnownResourceTypes []ResourceType = []ResourceType{
+		ResourceTypeKafka,
+		ResourceTypeConnector,
+		ResourceTypeKSQL,
+		ResourceTypeSchemaRegistry,
+	}
 )
 
 //ResourceType represents a returned Resource Type from the API
@@ -20,6 +28,16 @@ type ResourceType struct {
 	KnownMetrics []metric.Metric   `json:"metrics,omitempty"`
 }
 
+//Lookup finds a known Resource Type by its type name, such as `kafka`
+func Lookup(t string) (ResourceType, bool) {
+	for _, rt := range KnownResourceTypes {
+		if rt.Type == t {
+			return rt, true
+		}
+	}
+	return ResourceType{}, false
+}
+
 func NewResourceType(t string, metrics []metric.Metric, labels ...labels.Resource) ResourceType {
 	return ResourceType{
 		Type:         t,
@@ -0,0 +1,92 @@
+package response
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+)
+
+//LabelValue is a single label value as returned from the API, which can be either a string or a number
+type LabelValue struct {
+	Key string
+	Raw interface{}
+}
+
+func (v LabelValue) String() string {
+	return filter.FormatValue(v.Raw)
+}
+
+//IsNumeric checks if the value is a number, or a string that holds a number
+func (v LabelValue) IsNumeric() bool {
+	_, err := v.Float()
+	return err == nil
+}
+
+//Float returns the value as a float64
+func (v LabelValue) Float() (float64, error) {
+	switch val := v.Raw.(type) {
+	case float64:
+		return val, nil
+	case string:
+		return strconv.ParseFloat(val, 64)
+	default:
+		return 0, fmt.Errorf("Label `%s` value `%v` is not a number", v.Key, v.Raw)
+	}
+}
+
+//Int returns the value as an int64
+func (v LabelValue) Int() (int64, error) {
+	switch val := v.Raw.(type) {
+	case float64:
+		return int64(val), nil
+	case string:
+		return strconv.ParseInt(val, 10, 64)
+	default:
+		return 0, fmt.Errorf("Label `%s` value `%v` is not a number", v.Key, v.Raw)
+	}
+}
+
+//Compare compares two label values, numerically when both are numbers, otherwise as strings
+func (v LabelValue) Compare(other LabelValue) int {
+	return filter.CompareValues(v.Raw, other.Raw)
+}
+
+//LabelTuple is the set of label values, in group by order, for a single result of a label query
+type LabelTuple []LabelValue
+
+//Get returns the value of a given label within the tuple
+func (t LabelTuple) Get(l labels.Label) (LabelValue, bool) {
+	key := labels.Key(l)
+	for _, v := range t {
+		if v.Key == key {
+			return v, true
+		}
+	}
+	return LabelValue{}, false
+}
+
+//Strings returns all the values of the tuple as strings
+func (t LabelTuple) Strings() []string {
+	values := make([]string, len(t))
+	for i, v := range t {
+		values[i] = v.String()
+	}
+	return values
+}
+
+//Compare compares two tuples value by value
+func (t LabelTuple) Compare(other LabelTuple) int {
+	for i := 0; i < len(t) && i < len(other); i++ {
+		if c := t[i].Compare(other[i]); c != 0 {
+			return c
+		}
+	}
+	return len(t) - len(other)
+}
+
+func (t LabelTuple) String() string {
+	return "(" + strings.Join(t.Strings(), ", ") + ")"
+}
@@ -0,0 +1,48 @@
+package response
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTelemetry_LabelValue(t *testing.T) {
+	assert := assert.New(t)
+
+	d := Telemetry{}
+	err := json.Unmarshal([]byte(`{"metric.topic":"orders","metric.partition":3}`), &d)
+	assert.Nil(err)
+
+	topic, ok := d.LabelValue(labels.MetricTopic)
+	assert.True(ok)
+	assert.Equal("orders", topic.String())
+	assert.False(topic.IsNumeric())
+
+	partition, ok := d.LabelValue(labels.MetricPartition)
+	assert.True(ok)
+	assert.Equal("3", partition.String(), "Numeric values should format without decimals")
+	i, err := partition.Int()
+	assert.Nil(err)
+	assert.Equal(int64(3), i)
+
+	_, ok = d.LabelValue(labels.MetricType)
+	assert.False(ok)
+}
+
+func TestLabelTuple_Compare(t *testing.T) {
+	assert := assert.New(t)
+
+	a := LabelTuple{{Key: "metric.topic", Raw: "orders"}, {Key: "metric.partition", Raw: float64(2)}}
+	b := LabelTuple{{Key: "metric.topic", Raw: "orders"}, {Key: "metric.partition", Raw: float64(10)}}
+	c := LabelTuple{{Key: "metric.topic", Raw: "payments"}, {Key: "metric.partition", Raw: float64(0)}}
+
+	assert.True(a.Compare(b) < 0, "Numeric values should compare numerically")
+	assert.True(b.Compare(c) < 0)
+	assert.Equal(0, a.Compare(a))
+
+	v, ok := a.Get(labels.MetricPartition)
+	assert.True(ok)
+	assert.Equal("2", v.String())
+}
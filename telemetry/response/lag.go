@@ -0,0 +1,99 @@
+package response
+
+import (
+	"sort"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+)
+
+//ConsumerLag is the lag, in offsets, of a Consumer Group on a single topic partition at a point in time
+type ConsumerLag struct {
+	Timestamp     time.Time `json:"timestamp"`
+	ConsumerGroup string    `json:"consumer_group_id"`
+	Topic         string    `json:"topic"`
+	Partition     int64     `json:"partition"`
+	Lag           float64   `json:"lag"`
+}
+
+//ConsumerGroupLag is the lag of a Consumer Group across all of its topic partitions at a point in time
+type ConsumerGroupLag struct {
+	Timestamp     time.Time     `json:"timestamp"`
+	ConsumerGroup string        `json:"consumer_group_id"`
+	MaxLag        float64       `json:"max_lag"`
+	SumLag        float64       `json:"sum_lag"`
+	Partitions    []ConsumerLag `json:"partitions,omitempty"`
+}
+
+//SumLagByTopic returns the total lag of the Consumer Group per topic
+func (l ConsumerGroupLag) SumLagByTopic() map[string]float64 {
+	topics := map[string]float64{}
+	for _, p := range l.Partitions {
+		topics[p.Topic] += p.Lag
+	}
+	return topics
+}
+
+//NewConsumerLag converts a consumer lag data point, grouped by consumer group, topic, and partition, into a ConsumerLag
+func NewConsumerLag(d Telemetry) ConsumerLag {
+	lag := ConsumerLag{
+		Timestamp: d.Timestamp,
+		Lag:       d.Value,
+	}
+	lag.ConsumerGroup, _ = d.Label(labels.MetricConsumerGroupID)
+	lag.Topic, _ = d.Label(labels.MetricTopic)
+	if p, ok := d.LabelValue(labels.MetricPartition); ok {
+		lag.Partition, _ = p.Int()
+	}
+	return lag
+}
+
+//ConsumerGroupLags rolls up consumer lag data points into the max and sum of lag per Consumer Group and timestamp.
+//Results are sorted by Consumer Group and then timestamp, with each group's partitions sorted by topic and partition.
+func ConsumerGroupLags(data []Telemetry) []ConsumerGroupLag {
+	type groupKey struct {
+		group     string
+		timestamp int64
+	}
+
+	groups := map[groupKey]*ConsumerGroupLag{}
+	for _, d := range data {
+		lag := NewConsumerLag(d)
+		key := groupKey{lag.ConsumerGroup, lag.Timestamp.UnixNano()}
+
+		g, ok := groups[key]
+		if !ok {
+			g = &ConsumerGroupLag{
+				Timestamp:     lag.Timestamp,
+				ConsumerGroup: lag.ConsumerGroup,
+				MaxLag:        lag.Lag,
+			}
+			groups[key] = g
+		}
+
+		if lag.Lag > g.MaxLag {
+			g.MaxLag = lag.Lag
+		}
+		g.SumLag += lag.Lag
+		g.Partitions = append(g.Partitions, lag)
+	}
+
+	results := make([]ConsumerGroupLag, 0, len(groups))
+	for _, g := range groups {
+		sort.Slice(g.Partitions, func(i, j int) bool {
+			if g.Partitions[i].Topic != g.Partitions[j].Topic {
+				return g.Partitions[i].Topic < g.Partitions[j].Topic
+			}
+			return g.Partitions[i].Partition < g.Partitions[j].Partition
+		})
+		results = append(results, *g)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].ConsumerGroup != results[j].ConsumerGroup {
+			return results[i].ConsumerGroup < results[j].ConsumerGroup
+		}
+		return results[i].Timestamp.Before(results[j].Timestamp)
+	})
+
+	return results
+}
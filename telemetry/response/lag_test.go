@@ -0,0 +1,45 @@
+package response
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsumerGroupLags(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	point := func(group string, topic string, partition float64, lag float64) Telemetry {
+		return Telemetry{
+			Timestamp: ts,
+			Value:     lag,
+			Fields: map[string]interface{}{
+				"metric.consumer_group_id": group,
+				"metric.topic":             topic,
+				"metric.partition":         partition,
+			},
+		}
+	}
+
+	lags := ConsumerGroupLags([]Telemetry{
+		point("billing", "orders", 1, 10),
+		point("billing", "orders", 0, 30),
+		point("billing", "payments", 0, 5),
+		point("audit", "orders", 0, 2),
+	})
+
+	assert.Len(lags, 2, "Expected 1 result per consumer group")
+
+	assert.Equal("audit", lags[0].ConsumerGroup)
+	assert.Equal(2.0, lags[0].MaxLag)
+	assert.Equal(2.0, lags[0].SumLag)
+
+	assert.Equal("billing", lags[1].ConsumerGroup)
+	assert.Equal(30.0, lags[1].MaxLag)
+	assert.Equal(45.0, lags[1].SumLag)
+	assert.Len(lags[1].Partitions, 3)
+	assert.Equal(int64(0), lags[1].Partitions[0].Partition, "Partitions should be sorted")
+	assert.Equal(map[string]float64{"orders": 40, "payments": 5}, lags[1].SumLagByTopic())
+}
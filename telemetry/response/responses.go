@@ -18,8 +18,9 @@ type Meta struct {
 
 //MetaPagination is a struct to house the Pagination information for a given result
 type MetaPagination struct {
-	PageSize  int `json:"page_size"`
-	TotalSize int `json:"total_size,omitempty"`
+	PageSize      int    `json:"page_size"`
+	TotalSize     int    `json:"total_size,omitempty"`
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
 
 //Links represents the Links return data
@@ -33,6 +34,14 @@ type Query struct {
 	Data []Telemetry `json:"data"`
 }
 
+//NextPageToken returns the token to fetch the next page of results with, or an empty string when there are no more pages
+func (q Query) NextPageToken() string {
+	if q.BaseResponse == nil {
+		return ""
+	}
+	return q.Meta.Pagination.NextPageToken
+}
+
 //Resources respresents a collection of resources as returned from lookup of resources
 type Resources struct {
 	*BaseResponse
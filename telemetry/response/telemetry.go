@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"io"
 	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
 )
 
 //Telemetry is a struct that represents a given query result's data point
@@ -15,6 +18,41 @@ type Telemetry struct {
 	Fields    map[string]interface{}
 }
 
+//Label returns the value of a given label for this data point as a string
+func (t Telemetry) Label(l labels.Label) (string, bool) {
+	val, ok := t.LabelValue(l)
+	if !ok {
+		return "", false
+	}
+	return val.String(), true
+}
+
+//LabelValue returns the type safe value of a given label for this data point
+func (t Telemetry) LabelValue(l labels.Label) (LabelValue, bool) {
+	key := labels.Key(l)
+	val, ok := t.Fields[key]
+	if !ok || val == nil {
+		return LabelValue{}, false
+	}
+	return LabelValue{Key: key, Raw: val}, true
+}
+
+//Matches evaluates a Filter against the labels of this data point. A nil Filter matches everything
+func (t Telemetry) Matches(f filter.Filter) bool {
+	return filter.Matches(f, filter.Values(t.Fields))
+}
+
+//Select returns the data points that match the Filter
+func Select(f filter.Filter, data []Telemetry) []Telemetry {
+	selected := []Telemetry{}
+	for _, d := range data {
+		if d.Matches(f) {
+			selected = append(selected, d)
+		}
+	}
+	return selected
+}
+
 func (t *Telemetry) UnmarshalJSON(js []byte) error {
 	dec := json.NewDecoder(bytes.NewReader(js))
 	for {
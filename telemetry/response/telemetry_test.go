@@ -0,0 +1,24 @@
+package response
+
+import (
+	"testing"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelect(t *testing.T) {
+	assert := assert.New(t)
+	data := []Telemetry{
+		{Value: 1, Fields: map[string]interface{}{"metric.topic": "orders"}},
+		{Value: 2, Fields: map[string]interface{}{"metric.topic": "payments"}},
+		{Value: 3},
+	}
+
+	selected := Select(filter.NotEqualTo(labels.MetricTopic, "orders"), data)
+	assert.Len(selected, 2, "Data points without the field should match the negation")
+	assert.Equal(2.0, selected[0].Value)
+	assert.True(data[0].Matches(filter.EqualTo(labels.MetricTopic, "orders")))
+	assert.True(data[2].Matches(nil), "A nil filter should match everything")
+}
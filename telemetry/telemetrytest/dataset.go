@@ -0,0 +1,165 @@
+package telemetrytest
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+)
+
+//Point is a single raw data point within the fake dataset, before any bucketing or aggregation
+type Point struct {
+	Metric    string
+	Timestamp time.Time
+	Value     float64
+	//Labels are keyed by their full API key, such as `resource.kafka.id` or `metric.topic`
+	Labels map[string]string
+}
+
+//NewPoint creates a Point from a list of label key and value pairs, such as `"resource.kafka.id", "lkc-1", "metric.topic", "orders"`
+func NewPoint(metric string, timestamp time.Time, value float64, labelPairs ...string) Point {
+	p := Point{
+		Metric:    metric,
+		Timestamp: timestamp,
+		Value:     value,
+		Labels:    map[string]string{},
+	}
+	for i := 0; i+1 < len(labelPairs); i += 2 {
+		p.Labels[labelPairs[i]] = labelPairs[i+1]
+	}
+	return p
+}
+
+//Get returns the value of a given label key
+func (p Point) Get(key string) (string, bool) {
+	v, ok := p.Labels[key]
+	return v, ok
+}
+
+//series is the set of points for a single group within a single bucket
+type series struct {
+	timestamp time.Time
+	labels    []string
+	values    []float64
+}
+
+//aggregate buckets points by granularity, within the given intervals, and groups them by the given labels
+func aggregate(points []Point, gran granularity.Granularity, intervals []interval.Interval, groupBy []string, agg string) []response.Telemetry {
+	buckets := map[string]*series{}
+	for _, p := range points {
+		for _, i := range intervals {
+			start := i.Start()
+			if p.Timestamp.Before(start) || !p.Timestamp.Before(i.End()) {
+				continue
+			}
+
+			ts := start
+			if gran.Duration > 0 {
+				ts = start.Add(p.Timestamp.Sub(start) / gran.Duration * gran.Duration)
+			}
+
+			values := make([]string, len(groupBy))
+			for g, key := range groupBy {
+				values[g] = p.Labels[key]
+			}
+
+			key := ts.Format(time.RFC3339) + "\x00" + strings.Join(values, "\x00")
+			s, ok := buckets[key]
+			if !ok {
+				s = &series{timestamp: ts, labels: values}
+				buckets[key] = s
+			}
+			s.values = append(s.values, p.Value)
+			break
+		}
+	}
+
+	all := make([]*series, 0, len(buckets))
+	for _, s := range buckets {
+		all = append(all, s)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if !all[i].timestamp.Equal(all[j].timestamp) {
+			return all[i].timestamp.Before(all[j].timestamp)
+		}
+		return strings.Join(all[i].labels, "\x00") < strings.Join(all[j].labels, "\x00")
+	})
+
+	data := make([]response.Telemetry, len(all))
+	for i, s := range all {
+		d := response.Telemetry{
+			Timestamp: s.timestamp,
+			Value:     reduce(agg, s.values),
+		}
+		if len(groupBy) > 0 {
+			d.Fields = map[string]interface{}{}
+			for g, key := range groupBy {
+				d.Fields[key] = s.labels[g]
+			}
+		}
+		data[i] = d
+	}
+	return data
+}
+
+//distinct returns the distinct, sorted, tuples of the given labels across the points within the given intervals
+func distinct(points []Point, intervals []interval.Interval, groupBy []string) []map[string]string {
+	seen := map[string]bool{}
+	tuples := [][]string{}
+	for _, p := range points {
+		if len(intervals) > 0 && !within(p.Timestamp, intervals) {
+			continue
+		}
+
+		values := make([]string, len(groupBy))
+		for g, key := range groupBy {
+			values[g] = p.Labels[key]
+		}
+		key := strings.Join(values, "\x00")
+		if !seen[key] {
+			seen[key] = true
+			tuples = append(tuples, values)
+		}
+	}
+	sort.Slice(tuples, func(i, j int) bool {
+		return strings.Join(tuples[i], "\x00") < strings.Join(tuples[j], "\x00")
+	})
+
+	results := make([]map[string]string, len(tuples))
+	for i, t := range tuples {
+		results[i] = map[string]string{}
+		for g, key := range groupBy {
+			results[i][key] = t[g]
+		}
+	}
+	return results
+}
+
+func within(t time.Time, intervals []interval.Interval) bool {
+	for _, i := range intervals {
+		if !t.Before(i.Start()) && t.Before(i.End()) {
+			return true
+		}
+	}
+	return false
+}
+
+//reduce aggregates the values of a single series with either SUM, MIN or MAX
+func reduce(agg string, values []float64) float64 {
+	result := values[0]
+	for _, v := range values[1:] {
+		switch agg {
+		case "SUM":
+			result += v
+		case "MIN":
+			result = math.Min(result, v)
+		case "MAX":
+			result = math.Max(result, v)
+		}
+	}
+	return result
+}
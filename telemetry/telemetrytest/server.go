@@ -0,0 +1,495 @@
+//Package telemetrytest provides an in-process fake of the Telemetry API, backed by an in-memory dataset, for testing code built on the TelemetryClient without a network.
+//
+//	srv := telemetrytest.NewServer()
+//	defer srv.Close()
+//	srv.Add(telemetrytest.NewPoint(metric.KafkaServerReceivedBytes.Name, ts, 1024, "resource.kafka.id", "lkc-1", "metric.topic", "orders"))
+//
+//	client := telemetry.New("key", "secret")
+//	client.Context.BaseURL = srv.URL
+package telemetrytest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/resourcetype"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/response"
+)
+
+const (
+	//DefaultDataset is the dataset the fake server serves, matching the TelemetryClient's default
+	DefaultDataset string = "cloud"
+	//DefaultPageSize is the number of results per page when a query has no limit
+	DefaultPageSize int = 1000
+)
+
+//Fault injects a failure, or latency, into the responses of the fake server
+type Fault struct {
+	//Endpoint limits the fault to a single endpoint, such as `query` or `descriptors/metrics`. Empty applies to all endpoints
+	Endpoint string
+	//StatusCode to respond with. 0 responds as normal, after any Latency
+	StatusCode int
+	//Latency to wait before responding
+	Latency time.Duration
+	//Times is the number of requests to apply the fault to. 0 applies it until the faults are cleared
+	Times int
+}
+
+//Request is a request received by the fake server
+type Request struct {
+	Method   string
+	Endpoint string
+	Query    string
+	Body     []byte
+}
+
+//Server is a fake Telemetry API
+type Server struct {
+	*httptest.Server
+	Dataset string
+	//Metrics are served by the descriptor endpoints. Defaults to all the known metrics
+	Metrics []metric.Metric
+	//ResourceTypes are served by the descriptor endpoints. Defaults to all the known resource types
+	ResourceTypes []resourcetype.ResourceType
+
+	mutex    sync.Mutex
+	points   []Point
+	faults   []*Fault
+	requests []Request
+}
+
+//NewServer starts a new fake Telemetry API with an empty dataset. Close must be called once done
+func NewServer() *Server {
+	s := &Server{
+		Dataset:       DefaultDataset,
+		ResourceTypes: resourcetype.KnownResourceTypes,
+	}
+	for _, rt := range resourcetype.KnownResourceTypes {
+		s.Metrics = append(s.Metrics, rt.KnownMetrics...)
+	}
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+//Add loads points into the dataset
+func (s *Server) Add(points ...Point) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.points = append(s.points, points...)
+}
+
+//Reset removes all the points, faults and received requests
+func (s *Server) Reset() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.points = nil
+	s.faults = nil
+	s.requests = nil
+}
+
+//InjectFault adds a fault to be applied to the following requests
+func (s *Server) InjectFault(f Fault) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.faults = append(s.faults, &f)
+}
+
+//ClearFaults removes all the injected faults
+func (s *Server) ClearFaults() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.faults = nil
+}
+
+//Requests returns all the requests received so far
+func (s *Server) Requests() []Request {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]Request{}, s.requests...)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	endpoint, ok := s.endpoint(r.URL.Path)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Unknown path `%s`", r.URL.Path))
+		return
+	}
+
+	fault := s.record(Request{Method: r.Method, Endpoint: endpoint, Query: r.URL.RawQuery, Body: body})
+	if fault != nil {
+		select {
+		case <-time.After(fault.Latency):
+		case <-r.Context().Done():
+			return
+		}
+		if fault.StatusCode != 0 {
+			writeError(w, fault.StatusCode, fmt.Sprintf("Injected fault for `%s`", endpoint))
+			return
+		}
+	}
+
+	switch {
+	case endpoint == "query" && r.Method == http.MethodPost:
+		s.serveQuery(w, r, body)
+	case endpoint == "attributes" && r.Method == http.MethodPost:
+		s.serveAttributes(w, r, body)
+	case endpoint == "descriptors" && r.Method == http.MethodGet:
+		writeJSON(w, map[string]interface{}{"data": describeMetrics(s.Metrics)})
+	case endpoint == "descriptors/metrics" && r.Method == http.MethodGet:
+		s.serveMetricDescriptors(w, r)
+	case endpoint == "descriptors/resources" && r.Method == http.MethodGet:
+		writeJSON(w, map[string]interface{}{"data": describeResources(s.ResourceTypes)})
+	case endpoint == "export" && r.Method == http.MethodGet:
+		s.serveExport(w, r)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Unknown endpoint %s `%s`", r.Method, endpoint))
+	}
+}
+
+//endpoint strips the version and dataset from a path, such as `/v2/metrics/cloud/query`
+func (s *Server) endpoint(path string) (string, bool) {
+	parts := strings.SplitN(strings.Trim(path, "/"), "/", 4)
+	if len(parts) < 4 || (parts[0] != "v1" && parts[0] != "v2") || parts[1] != "metrics" || parts[2] != s.Dataset {
+		return "", false
+	}
+	return parts[3], true
+}
+
+//record saves the request and returns the first fault that applies to it
+func (s *Server) record(req Request) *Fault {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests = append(s.requests, req)
+
+	for i, f := range s.faults {
+		if f.Endpoint != "" && f.Endpoint != req.Endpoint {
+			continue
+		}
+		fault := *f
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return &fault
+	}
+	return nil
+}
+
+//wireQuery is the JSON form of a query.Query, as received by the fake server
+type wireQuery struct {
+	Aggregations []agg.Aggregation `json:"aggregations"`
+	Filter       json.RawMessage   `json:"filter"`
+	Granularity  string            `json:"granularity"`
+	GroupBy      []string          `json:"group_by"`
+	Intervals    []string          `json:"intervals"`
+	Limit        int               `json:"limit"`
+	Metric       string            `json:"metric"`
+}
+
+func (s *Server) serveQuery(w http.ResponseWriter, r *http.Request, body []byte) {
+	q := wireQuery{}
+	if err := json.Unmarshal(body, &q); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(q.Aggregations) != 1 {
+		writeError(w, http.StatusBadRequest, "Exactly 1 aggregation is required")
+		return
+	}
+	a := q.Aggregations[0]
+	if a.Agg != agg.AggSum && a.Agg != agg.AggMin && a.Agg != agg.AggMax {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Unsupported aggregation `%s`", a.Agg))
+		return
+	}
+	gran, err := granularity.Parse(q.Granularity)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	intervals, err := parseIntervals(q.Intervals)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(intervals) <= 0 {
+		writeError(w, http.StatusBadRequest, "At least 1 interval is required")
+		return
+	}
+
+	points, err := s.selectPoints(a.Metric, q.Filter)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	data := aggregate(points, gran, intervals, q.GroupBy, a.Agg)
+	results := make([]interface{}, len(data))
+	for i, d := range data {
+		result := map[string]interface{}{
+			"timestamp": d.Timestamp.Format(time.RFC3339),
+			"value":     d.Value,
+		}
+		for k, v := range d.Fields {
+			result[k] = v
+		}
+		results[i] = result
+	}
+	writePage(w, r, results, q.Limit)
+}
+
+func (s *Server) serveAttributes(w http.ResponseWriter, r *http.Request, body []byte) {
+	q := wireQuery{}
+	if err := json.Unmarshal(body, &q); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if len(q.GroupBy) <= 0 {
+		writeError(w, http.StatusBadRequest, "At least 1 group by label is required")
+		return
+	}
+	intervals, err := parseIntervals(q.Intervals)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	points, err := s.selectPoints(q.Metric, q.Filter)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tuples := distinct(points, intervals, q.GroupBy)
+	results := make([]interface{}, len(tuples))
+	for i, t := range tuples {
+		results[i] = t
+	}
+	writePage(w, r, results, q.Limit)
+}
+
+func (s *Server) serveMetricDescriptors(w http.ResponseWriter, r *http.Request) {
+	rtName := r.URL.Query().Get("resource_type")
+	rt, ok := resourcetype.Lookup(rtName)
+	if !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Unknown resource_type `%s`", rtName))
+		return
+	}
+
+	metrics := []metric.Metric{}
+	for _, m := range s.Metrics {
+		for _, known := range rt.KnownMetrics {
+			if known.Name == m.Name {
+				metrics = append(metrics, m)
+				break
+			}
+		}
+	}
+	writeJSON(w, map[string]interface{}{"data": describeMetrics(metrics)})
+}
+
+//serveExport writes the latest value of each series, for the requested resources, in the Prometheus text format
+func (s *Server) serveExport(w http.ResponseWriter, r *http.Request) {
+	resources := r.URL.Query()
+	if len(resources) <= 0 {
+		writeError(w, http.StatusBadRequest, "At least 1 resource is required")
+		return
+	}
+
+	s.mutex.Lock()
+	latest := map[string]Point{}
+	for _, p := range s.points {
+		for key, ids := range resources {
+			if v, ok := p.Get(key); ok && contains(ids, v) {
+				series := exportSeries(p)
+				if l, ok := latest[series]; !ok || p.Timestamp.After(l.Timestamp) {
+					latest[series] = p
+				}
+				break
+			}
+		}
+	}
+	s.mutex.Unlock()
+
+	series := make([]string, 0, len(latest))
+	for k := range latest {
+		series = append(series, k)
+	}
+	sort.Strings(series)
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	for _, k := range series {
+		p := latest[k]
+		fmt.Fprintf(w, "%s %s %d\n", k, strconv.FormatFloat(p.Value, 'f', -1, 64), p.Timestamp.UnixNano()/int64(time.Millisecond))
+	}
+}
+
+//selectPoints returns the points for a given metric that match the given JSON filter
+func (s *Server) selectPoints(metricName string, js json.RawMessage) ([]Point, error) {
+	var fil filter.Filter
+	if len(js) > 0 {
+		var err error
+		if fil, err = filter.Unmarshal(js); err != nil {
+			return nil, err
+		}
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	points := []Point{}
+	for _, p := range s.points {
+		if metricName != "" && p.Metric != metricName {
+			continue
+		}
+		if filter.Matches(fil, filter.ValuesOf(p.Labels)) {
+			points = append(points, p)
+		}
+	}
+	return points, nil
+}
+
+//writePage writes a single page of results, along with the token for the next page when there are more results
+func writePage(w http.ResponseWriter, r *http.Request, results []interface{}, limit int) {
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+
+	offset := 0
+	if token := r.URL.Query().Get("page_token"); token != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(token)
+		if err == nil {
+			offset, err = strconv.Atoi(string(decoded))
+		}
+		if err != nil || offset < 0 || offset > len(results) {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid page_token `%s`", token))
+			return
+		}
+	}
+
+	end := offset + limit
+	pagination := response.MetaPagination{PageSize: limit, TotalSize: len(results)}
+	if end < len(results) {
+		pagination.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+	} else {
+		end = len(results)
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"data": results[offset:end],
+		"meta": response.Meta{Pagination: pagination},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, detail string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]string{{
+			"status": strconv.Itoa(statusCode),
+			"detail": detail,
+		}},
+	})
+}
+
+func parseIntervals(values []string) ([]interval.Interval, error) {
+	intervals := make([]interval.Interval, len(values))
+	for i, v := range values {
+		inter, err := interval.Parse(v)
+		if err != nil {
+			return nil, err
+		}
+		intervals[i] = inter
+	}
+	return intervals, nil
+}
+
+//describeMetrics returns the descriptor form of metrics, as the Metric type marshals to just its name
+func describeMetrics(metrics []metric.Metric) []interface{} {
+	descs := make([]interface{}, len(metrics))
+	for i, m := range metrics {
+		m = m.Describe()
+		lbls := make([]map[string]string, len(m.Labels))
+		for l, lbl := range m.Labels {
+			lbls[l] = map[string]string{"key": lbl.Key, "description": lbl.Desc}
+		}
+		descs[i] = map[string]interface{}{
+			"name":            m.Name,
+			"description":     m.Desc,
+			"type":            m.Type,
+			"unit":            m.Unit,
+			"lifecycle_stage": m.LifecycleStage,
+			"labels":          lbls,
+		}
+	}
+	return descs
+}
+
+//describeResources returns the descriptor form of resource types, as the Resource label type marshals to just its key
+func describeResources(resourceTypes []resourcetype.ResourceType) []interface{} {
+	descs := make([]interface{}, len(resourceTypes))
+	for i, rt := range resourceTypes {
+		lbls := make([]map[string]string, len(rt.Labels))
+		for l, lbl := range rt.Labels {
+			lbls[l] = map[string]string{"key": lbl.Key, "description": lbl.Desc}
+		}
+		descs[i] = map[string]interface{}{
+			"type":        rt.Type,
+			"description": rt.Desc,
+			"labels":      lbls,
+		}
+	}
+	return descs
+}
+
+//exportSeries builds the Prometheus series name, with labels, of a point
+func exportSeries(p Point) string {
+	name := strings.TrimPrefix(p.Metric, "io.")
+	name = strings.NewReplacer(".", "_", "/", "_").Replace(name)
+
+	lbls := make([]string, 0, len(p.Labels))
+	for k, v := range p.Labels {
+		key := strings.TrimPrefix(strings.TrimPrefix(k, "resource."), "metric.")
+		lbls = append(lbls, fmt.Sprintf("%s=%s", strings.Replace(key, ".", "_", -1), strconv.Quote(v)))
+	}
+	sort.Strings(lbls)
+	if len(lbls) <= 0 {
+		return name
+	}
+	return name + "{" + strings.Join(lbls, ",") + "}"
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package telemetrytest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nerdynick/ccloud-go-sdk/client"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/labels"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/metric"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/agg"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/filter"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/granularity"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/group"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/query/interval"
+	"github.com/nerdynick/ccloud-go-sdk/telemetry/resourcetype"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	start    = time.Date(2021, 4, 20, 0, 0, 0, 0, time.UTC)
+	received = metric.KafkaServerReceivedBytes.Name
+)
+
+func newTestServer() (*Server, *telemetry.TelemetryClient) {
+	srv := NewServer()
+	for m := 0; m < 10; m++ {
+		ts := start.Add(time.Duration(m) * time.Minute)
+		srv.Add(
+			NewPoint(received, ts, 1, "resource.kafka.id", "lkc-1", "metric.topic", "orders", "metric.partition", "0"),
+			NewPoint(received, ts, 2, "resource.kafka.id", "lkc-1", "metric.topic", "orders", "metric.partition", "1"),
+			NewPoint(received, ts, 4, "resource.kafka.id", "lkc-1", "metric.topic", "payments", "metric.partition", "0"),
+			NewPoint(received, ts, 8, "resource.kafka.id", "lkc-2", "metric.topic", "orders", "metric.partition", "0"),
+		)
+	}
+
+	client := telemetry.New("key", "secret")
+	client.Context.BaseURL = srv.URL
+	return srv, &client
+}
+
+func TestServerQuery(t *testing.T) {
+	assert := assert.New(t)
+	srv, tc := newTestServer()
+	defer srv.Close()
+
+	res, err := tc.PostMetricsQuery(query.Query{
+		Aggregations: agg.Of(agg.SumOf(metric.KafkaServerReceivedBytes)),
+		Filter:       filter.EqualTo(labels.ResourceKafka, "lkc-1").AndNotEqualTo(labels.MetricTopic, "payments"),
+		Granularity:  granularity.FiveMin,
+		GroupBy:      group.Of(labels.MetricTopic),
+		Intervals:    interval.Of(interval.StartingFrom(start, time.Hour)),
+	})
+	assert.NoError(err)
+	assert.Len(res.Data, 2, "Points should be bucketed into 5 minute windows")
+	assert.Equal(start, res.Data[0].Timestamp)
+	assert.Equal(15.0, res.Data[0].Value, "Both partitions of 5 minutes of orders should be summed")
+	assert.Equal("orders", res.Data[0].Fields["metric.topic"])
+	assert.Equal(start.Add(5*time.Minute), res.Data[1].Timestamp)
+
+	res, err = tc.PostMetricsQuery(query.Query{
+		Aggregations: agg.Of(agg.MaxOf(metric.KafkaServerReceivedBytes)),
+		Filter:       filter.GreaterThan(labels.MetricPartition, "0"),
+		Granularity:  granularity.OneHour,
+		Intervals:    interval.Of(interval.StartingFrom(start, time.Hour)),
+	})
+	assert.NoError(err)
+	assert.Len(res.Data, 1)
+	assert.Equal(2.0, res.Data[0].Value, "Partitions should be compared numerically")
+}
+
+func TestServerPagination(t *testing.T) {
+	assert := assert.New(t)
+	srv, tc := newTestServer()
+	defer srv.Close()
+
+	tuples, err := tc.LabelValues(telemetry.LabelValuesQuery{
+		Metric:    metric.KafkaServerReceivedBytes,
+		GroupBy:   []labels.Label{labels.ResourceKafka, labels.MetricTopic},
+		Interval:  interval.StartingFrom(start, time.Hour),
+		PageLimit: 1,
+	})
+	assert.NoError(err)
+	assert.Len(tuples, 3)
+	assert.Equal([]string{"lkc-2", "orders"}, tuples[2].Strings())
+	assert.Len(srv.Requests(), 3, "Each page should be a request")
+}
+
+func TestServerFaults(t *testing.T) {
+	assert := assert.New(t)
+	srv, tc := newTestServer()
+	defer srv.Close()
+
+	srv.InjectFault(Fault{Endpoint: "descriptors/resources", StatusCode: http.StatusTooManyRequests, Times: 1})
+	_, err := tc.GetAvailableResources()
+	assert.IsType(client.RateLimitedError{}, err)
+
+	resources, err := tc.GetAvailableResources()
+	assert.NoError(err, "The fault should only apply once")
+	assert.Len(resources, len(resourcetype.KnownResourceTypes))
+
+	srv.InjectFault(Fault{StatusCode: http.StatusServiceUnavailable})
+	_, err = tc.GetAvailableMetricsForResource(resourcetype.ResourceTypeKafka)
+	assert.Error(err)
+	_, err = tc.GetAvailableMetricsForResource(resourcetype.ResourceTypeKafka)
+	assert.Error(err, "The fault should apply until cleared")
+
+	srv.ClearFaults()
+	srv.InjectFault(Fault{Latency: 50 * time.Millisecond})
+	began := time.Now()
+	metrics, err := tc.GetAvailableMetricsForResource(resourcetype.ResourceTypeKafka)
+	assert.NoError(err)
+	assert.True(time.Since(began) >= 50*time.Millisecond)
+	assert.Len(metrics, len(resourcetype.ResourceTypeKafka.KnownMetrics))
+	assert.Equal(metric.KindDelta, metrics[0].Kind())
+}
+
+func TestServerExport(t *testing.T) {
+	assert := assert.New(t)
+	srv, _ := newTestServer()
+	defer srv.Close()
+
+	res, err := http.Get(srv.URL + "/v2/metrics/cloud/export?resource.kafka.id=lkc-2")
+	assert.NoError(err)
+	defer res.Body.Close()
+	body, _ := ioutil.ReadAll(res.Body)
+
+	assert.Equal(`confluent_kafka_server_received_bytes{kafka_id="lkc-2",partition="0",topic="orders"} 8 1618877340000
+`, string(body))
+}
+
+func TestServerNormalizedFilters(t *testing.T) {
+	assert := assert.New(t)
+	srv, tc := newTestServer()
+	defer srv.Close()
+
+	q := query.Query{
+		Aggregations: agg.Of(agg.SumOf(metric.KafkaServerReceivedBytes)),
+		Filter:       filter.And(filter.And(filter.EqualTo(labels.ResourceKafka, "lkc-1")), filter.Not(filter.Not(filter.EqualTo(labels.MetricTopic, "orders")))),
+		Granularity:  granularity.OneHour,
+		Intervals:    interval.Of(interval.StartingFrom(start, time.Hour)),
+	}
+	res, err := tc.PostMetricsQuery(q)
+	assert.NoError(err)
+	assert.Equal(30.0, res.Data[0].Value)
+	assert.Contains(string(srv.Requests()[0].Body), `"filter":{"op":"AND","filters":[{"op":"EQ","field":"resource.kafka.id","value":"lkc-1"},{"op":"EQ","field":"metric.topic","value":"orders"}]}`)
+
+	q.Filter = filter.EqualTo(labels.ResourceKafka, "lkc-1").AndEqualTo(labels.ResourceKafka, "lkc-2")
+	res, err = tc.PostMetricsQuery(q)
+	assert.NoError(err, "Contradictions should return empty results rather than fail")
+	assert.Empty(res.Data)
+	assert.NotNil(res.Data)
+	assert.Len(srv.Requests(), 1, "Contradictions should not be sent")
+}